@@ -25,12 +25,13 @@ func main() {
 
 	cfClient := cloudflare.NewClient(cfg.APIToken)
 
-	metricsRegistry := metrics.NewMetrics()
+	metricsRegistry := metrics.NewMetrics(cfg.FirewallDimensions)
 	metricsRegistry.Register()
 
-	col := collector.NewCollector(cfClient, metricsRegistry, cfg.ZoneID)
+	col := collector.NewCollector(cfClient, metricsRegistry, cfg)
 
 	startPeriodicCollection(col, cfg.ScrapeInterval)
+	startWaitingRoomCollection(col, cfg.WaitingRoomInterval)
 
 	setupHTTPServer(cfg.Port)
 }
@@ -51,6 +52,23 @@ func startPeriodicCollection(col *collector.Collector, interval time.Duration) {
 	}()
 }
 
+// startWaitingRoomCollection polls waiting rooms on their own, faster
+// interval so launch dashboards stay close to real time.
+func startWaitingRoomCollection(col *collector.Collector, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		if err := col.CollectWaitingRoomMetrics(); err != nil {
+			log.Printf("  Waiting room metrics: %v", err)
+		}
+
+		for range ticker.C {
+			if err := col.CollectWaitingRoomMetrics(); err != nil {
+				log.Printf("  Waiting room metrics: %v", err)
+			}
+		}
+	}()
+}
+
 func setupHTTPServer(port string) {
 	http.Handle("/metrics", promhttp.Handler())
 
@@ -0,0 +1,147 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const otherLabel = "other"
+
+func (c *Collector) CollectASNMetrics() error {
+	requests, err := c.queryASNGroups("httpRequestsAdaptiveGroups", `sum {
+						edgeResponseBytes
+					}`)
+	if err != nil {
+		return err
+	}
+
+	// Firewall events are only available on Pro plans and above; their
+	// absence should not prevent the request metrics from being exported.
+	firewall, err := c.queryASNGroups("firewallEventsAdaptiveGroups", "")
+	if err != nil {
+		log.Printf("ℹ️  ASN firewall metrics: %v", err)
+	}
+
+	return c.processASNMetrics(requests, firewall)
+}
+
+func (c *Collector) queryASNGroups(dataset, fields string) ([]interface{}, error) {
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	query := fmt.Sprintf(`{
+		viewer {
+			zones(filter: {zoneTag: "%s"}) {
+				%s(
+					limit: 10000
+					filter: {datetime_geq: "%s", datetime_leq: "%s"}
+				) {
+					count
+					%s
+					dimensions {
+						clientAsn
+						clientASNDescription
+					}
+				}
+			}
+		}
+	}`, c.zoneID, dataset, since.Format(time.RFC3339), now.Format(time.RFC3339), fields)
+
+	result, err := c.client.ExecuteQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	zones := result["data"].(map[string]interface{})["viewer"].(map[string]interface{})["zones"].([]interface{})
+	if len(zones) == 0 {
+		return nil, fmt.Errorf("no zones found")
+	}
+
+	zone := zones[0].(map[string]interface{})
+	groups, ok := zone[dataset].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s not available", dataset)
+	}
+
+	return groups, nil
+}
+
+func (c *Collector) processASNMetrics(requests, firewall []interface{}) error {
+	descriptions := make(map[string]string)
+	reqMap := make(map[string]int64)
+	bwMap := make(map[string]int64)
+	firewallMap := make(map[string]int64)
+
+	for _, g := range requests {
+		group := g.(map[string]interface{})
+		count := int64(group["count"].(float64))
+
+		asn, desc, ok := asnDimensions(group)
+		if !ok {
+			continue
+		}
+		descriptions[asn] = desc
+
+		reqMap[asn] += count
+		if sum, ok := group["sum"].(map[string]interface{}); ok {
+			if bw, ok := sum["edgeResponseBytes"].(float64); ok {
+				bwMap[asn] += int64(bw)
+			}
+		}
+	}
+
+	for _, g := range firewall {
+		group := g.(map[string]interface{})
+		asn, desc, ok := asnDimensions(group)
+		if !ok {
+			continue
+		}
+		if _, ok := descriptions[asn]; !ok {
+			descriptions[asn] = desc
+		}
+		firewallMap[asn] += int64(group["count"].(float64))
+	}
+
+	keep := make(map[string]bool)
+	for _, asn := range c.cfg.ASNAlwaysExport {
+		keep[asn] = true
+	}
+
+	c.setASNMetric(c.metrics.ASNRequests, reqMap, descriptions, keep)
+	c.setASNMetric(c.metrics.ASNBytes, bwMap, descriptions, keep)
+	c.setASNMetric(c.metrics.ASNFirewallEvents, firewallMap, descriptions, keep)
+
+	log.Printf(" ASN: %d networks | %d with firewall events", len(reqMap), len(firewallMap))
+
+	return nil
+}
+
+func (c *Collector) setASNMetric(vec *prometheus.GaugeVec, values map[string]int64, descriptions map[string]string, keep map[string]bool) {
+	top, other := getTopNWithOther(values, c.cfg.ASNTopN, keep)
+
+	vec.Reset()
+	for asn, v := range top {
+		vec.WithLabelValues(c.zoneID, asn, descriptions[asn]).Set(float64(v))
+	}
+	if other > 0 {
+		vec.WithLabelValues(c.zoneID, otherLabel, otherLabel).Set(float64(other))
+	}
+}
+
+func asnDimensions(group map[string]interface{}) (string, string, bool) {
+	dims, ok := group["dimensions"].(map[string]interface{})
+	if !ok {
+		return "", "", false
+	}
+
+	asn := dimensionString(dims["clientAsn"])
+	if asn == "" || asn == "0" {
+		return "", "", false
+	}
+	desc, _ := dims["clientASNDescription"].(string)
+
+	return asn, desc, true
+}
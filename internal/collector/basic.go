@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"cloudflare-exporter/internal/config"
 	"cloudflare-exporter/internal/metrics"
 	"cloudflare-exporter/pkg/cloudflare"
 )
@@ -12,14 +13,37 @@ import (
 type Collector struct {
 	client  *cloudflare.Client
 	metrics *metrics.Metrics
+	cfg     *config.Config
 	zoneID  string
+
+	coloLocations map[string]coloLocation
+
+	// botManagementDisabled is set once the zone is found to lack
+	// Bot Management so the collector stops querying it
+	botManagementDisabled bool
+
+	// waitingRoomsDisabled and waitingRoomsIdleUntil are only accessed
+	// from the waiting room loop
+	waitingRoomsDisabled  bool
+	waitingRoomsIdleUntil time.Time
+
+	// rules caches zone and account ruleset rules by rule ID
+	rules        map[string]ruleInfo
+	rulesFetched time.Time
+
+	// streamVideoNames caches Stream video names by UID
+	streamVideoNames map[string]string
 }
 
-func NewCollector(client *cloudflare.Client, metrics *metrics.Metrics, zoneID string) *Collector {
+func NewCollector(client *cloudflare.Client, metrics *metrics.Metrics, cfg *config.Config) *Collector {
 	return &Collector{
-		client:  client,
-		metrics: metrics,
-		zoneID:  zoneID,
+		client:        client,
+		metrics:       metrics,
+		cfg:           cfg,
+		zoneID:        cfg.ZoneID,
+		coloLocations: mergeColoLocations(cfg.ColoMapping),
+
+		streamVideoNames: make(map[string]string),
 	}
 }
 
@@ -41,9 +65,112 @@ func (c *Collector) CollectAll() error {
 		log.Printf("ℹ️  Firewall metrics: %v", err)
 	}
 
+	if err := c.CollectOriginMetrics(); err != nil {
+		log.Printf("  Origin metrics: %v", err)
+	}
+
+	if err := c.CollectProtocolMetrics(); err != nil {
+		log.Printf("  Protocol metrics: %v", err)
+	}
+
+	if err := c.CollectColoMetrics(); err != nil {
+		log.Printf("  Colo metrics: %v", err)
+	}
+
+	if err := c.CollectASNMetrics(); err != nil {
+		log.Printf("  ASN metrics: %v", err)
+	}
+
+	if err := c.CollectClientMetrics(); err != nil {
+		log.Printf("  Client metrics: %v", err)
+	}
+
+	if err := c.CollectBotMetrics(); err != nil {
+		log.Printf("  Bot metrics: %v", err)
+	}
+
+	if err := c.CollectLoadBalancerMetrics(); err != nil {
+		log.Printf("  Load balancer metrics: %v", err)
+	}
+
+	if err := c.CollectDNSMetrics(); err != nil {
+		log.Printf("  DNS metrics: %v", err)
+	}
+
+	if err := c.CollectRateLimitMetrics(); err != nil {
+		log.Printf("  Rate limit metrics: %v", err)
+	}
+
+	if err := c.CollectFirewallBreakdownMetrics(); err != nil {
+		log.Printf("  Firewall breakdown metrics: %v", err)
+	}
+
+	if err := c.CollectCertificateMetrics(); err != nil {
+		log.Printf("  Certificate metrics: %v", err)
+	}
+
+	if err := c.CollectZoneSettingMetrics(); err != nil {
+		log.Printf("  Zone setting metrics: %v", err)
+	}
+
+	if err := c.CollectDNSRecordMetrics(); err != nil {
+		log.Printf("  DNS record metrics: %v", err)
+	}
+
+	if err := c.CollectHealthCheckMetrics(); err != nil {
+		log.Printf("  Health check metrics: %v", err)
+	}
+
+	if err := c.CollectPageShieldMetrics(); err != nil {
+		log.Printf("  Page Shield metrics: %v", err)
+	}
+
+	if err := c.CollectAPIShieldMetrics(); err != nil {
+		log.Printf("  API Shield metrics: %v", err)
+	}
+
+	if err := c.CollectLogpushMetrics(); err != nil {
+		log.Printf("  Logpush metrics: %v", err)
+	}
+
+	if err := c.CollectSpectrumMetrics(); err != nil {
+		log.Printf("  Spectrum metrics: %v", err)
+	}
+
+	// Account-level collectors only run when an account ID is configured
+	if c.cfg.AccountID != "" {
+		c.collectAccountMetrics()
+	}
+
 	return nil
 }
 
+func (c *Collector) collectAccountMetrics() {
+	if err := c.CollectWorkersMetrics(); err != nil {
+		log.Printf("  Workers metrics: %v", err)
+	}
+
+	if err := c.CollectR2Metrics(); err != nil {
+		log.Printf("  R2 metrics: %v", err)
+	}
+
+	if err := c.CollectTunnelMetrics(); err != nil {
+		log.Printf("  Tunnel metrics: %v", err)
+	}
+
+	if err := c.CollectAccessMetrics(); err != nil {
+		log.Printf("  Access metrics: %v", err)
+	}
+
+	if err := c.CollectGatewayMetrics(); err != nil {
+		log.Printf("  Gateway metrics: %v", err)
+	}
+
+	if err := c.CollectMediaMetrics(); err != nil {
+		log.Printf("  Media metrics: %v", err)
+	}
+}
+
 func (c *Collector) CollectBasicMetrics() error {
 	now := time.Now()
 	since := now.Add(-24 * time.Hour)
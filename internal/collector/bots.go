@@ -0,0 +1,165 @@
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"cloudflare-exporter/pkg/cloudflare"
+)
+
+func (c *Collector) CollectBotMetrics() error {
+	if c.botManagementDisabled {
+		return nil
+	}
+
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	query := fmt.Sprintf(`{
+		viewer {
+			zones(filter: {zoneTag: "%s"}) {
+				httpRequestsAdaptiveGroups(
+					limit: 10000
+					filter: {datetime_geq: "%s", datetime_leq: "%s"}
+				) {
+					count
+					dimensions {
+						botScore
+						botManagementDecision
+						verifiedBotCategory
+						clientRequestHTTPHost
+					}
+				}
+			}
+		}
+	}`, c.zoneID, since.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	result, err := c.client.ExecuteQuery(query)
+	if err != nil {
+		// Bot Management fields are rejected by the API on plans without
+		// the add-on; stop asking instead of logging the same error forever.
+		// Any other GraphQL error (rate limits, query budget, ...) is treated
+		// as a normal failure and retried on the next cycle.
+		if isBotManagementUnavailable(err) {
+			c.botManagementDisabled = true
+			return fmt.Errorf("bot management not available, disabling collector (%v)", err)
+		}
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return c.processBotMetrics(result)
+}
+
+// isBotManagementUnavailable reports whether err is the API refusing the
+// Bot Management fields, as opposed to a transient query failure.
+func isBotManagementUnavailable(err error) bool {
+	var gqlErr *cloudflare.GraphQLError
+	if !errors.As(err, &gqlErr) {
+		return false
+	}
+
+	msg := strings.ToLower(gqlErr.Message)
+	if !strings.Contains(msg, "botscore") && !strings.Contains(msg, "botmanagementdecision") {
+		return false
+	}
+	for _, reason := range []string{"access", "unknown field", "cannot query field", "not authorized"} {
+		if strings.Contains(msg, reason) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Collector) processBotMetrics(data map[string]interface{}) error {
+	zones := data["data"].(map[string]interface{})["viewer"].(map[string]interface{})["zones"].([]interface{})
+	if len(zones) == 0 {
+		return fmt.Errorf("no zones found")
+	}
+
+	zone := zones[0].(map[string]interface{})
+	groups, ok := zone["httpRequestsAdaptiveGroups"].([]interface{})
+	if !ok {
+		return fmt.Errorf("bot metrics not available")
+	}
+
+	type hostValue struct {
+		host  string
+		value string
+	}
+
+	hostMap := make(map[string]int64)
+	scoreMap := make(map[hostValue]int64)
+	categoryMap := make(map[hostValue]int64)
+	decisionMap := make(map[hostValue]int64)
+
+	for _, g := range groups {
+		group := g.(map[string]interface{})
+		count := int64(group["count"].(float64))
+
+		dims, ok := group["dimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		host, _ := dims["clientRequestHTTPHost"].(string)
+		if host == "" {
+			continue
+		}
+		hostMap[host] += count
+
+		score, _ := dims["botScore"].(float64)
+		scoreMap[hostValue{host, botScoreBucket(int(score))}] += count
+
+		if category, ok := dims["verifiedBotCategory"].(string); ok && category != "" {
+			categoryMap[hostValue{host, category}] += count
+		}
+		if decision, ok := dims["botManagementDecision"].(string); ok && decision != "" {
+			decisionMap[hostValue{host, decision}] += count
+		}
+	}
+
+	topHosts := getTopN(hostMap, 20)
+
+	c.metrics.BotScoreRequests.Reset()
+	c.metrics.VerifiedBotRequests.Reset()
+	c.metrics.BotDecisionRequests.Reset()
+
+	for hv, count := range scoreMap {
+		if _, ok := topHosts[hv.host]; ok {
+			c.metrics.BotScoreRequests.WithLabelValues(c.zoneID, hv.host, hv.value).Set(float64(count))
+		}
+	}
+	for hv, count := range categoryMap {
+		if _, ok := topHosts[hv.host]; ok {
+			c.metrics.VerifiedBotRequests.WithLabelValues(c.zoneID, hv.host, hv.value).Set(float64(count))
+		}
+	}
+	for hv, count := range decisionMap {
+		if _, ok := topHosts[hv.host]; ok {
+			c.metrics.BotDecisionRequests.WithLabelValues(c.zoneID, hv.host, hv.value).Set(float64(count))
+		}
+	}
+
+	log.Printf(" Bots: %d hosts | %d verified bot categories", len(topHosts), len(categoryMap))
+
+	return nil
+}
+
+// botScoreBucket groups bot scores the way Cloudflare documents them:
+// 1 is automated, 2-29 likely automated and 30-99 likely human. A score
+// of 0 means no score was computed for the request.
+func botScoreBucket(score int) string {
+	switch {
+	case score <= 0:
+		return "unscored"
+	case score == 1:
+		return "1"
+	case score < 30:
+		return "2-29"
+	default:
+		return "30-99"
+	}
+}
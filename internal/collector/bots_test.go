@@ -0,0 +1,77 @@
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"cloudflare-exporter/pkg/cloudflare"
+)
+
+func TestBotScoreBucket(t *testing.T) {
+	tests := []struct {
+		score int
+		want  string
+	}{
+		{-1, "unscored"},
+		{0, "unscored"},
+		{1, "1"},
+		{2, "2-29"},
+		{29, "2-29"},
+		{30, "30-99"},
+		{99, "30-99"},
+	}
+
+	for _, tt := range tests {
+		if got := botScoreBucket(tt.score); got != tt.want {
+			t.Errorf("botScoreBucket(%d) = %q, want %q", tt.score, got, tt.want)
+		}
+	}
+}
+
+func TestIsBotManagementUnavailable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "no access to botScore",
+			err:  &cloudflare.GraphQLError{Message: "zone does not have access to the field botScore"},
+			want: true,
+		},
+		{
+			name: "unknown botManagementDecision field",
+			err:  fmt.Errorf("query: %w", &cloudflare.GraphQLError{Message: `unknown field "botManagementDecision"`}),
+			want: true,
+		},
+		{
+			name: "rate limited",
+			err:  &cloudflare.GraphQLError{Message: "rate limiter budget depleted, try again after 5 minutes"},
+			want: false,
+		},
+		{
+			name: "time range",
+			err:  &cloudflare.GraphQLError{Message: "cannot request data older than 2678400s"},
+			want: false,
+		},
+		{
+			name: "other field refused",
+			err:  &cloudflare.GraphQLError{Message: "zone does not have access to the field verifiedBotCategory"},
+			want: false,
+		},
+		{
+			name: "transport error",
+			err:  errors.New("failed to execute request: botScore access"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isBotManagementUnavailable(tt.err); got != tt.want {
+				t.Errorf("isBotManagementUnavailable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// certificate is the common view of edge, custom, custom hostname and
+// Origin CA certificates.
+type certificate struct {
+	source           string
+	id               string
+	hosts            string
+	status           string
+	issuer           string
+	validationMethod string
+	expiresOn        time.Time
+}
+
+// apiTimeLayouts covers the timestamp formats used across the REST API.
+var apiTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02T15:04:05Z",
+}
+
+func (c *Collector) CollectCertificateMetrics() error {
+	fetchers := []struct {
+		name   string
+		source string
+		fetch  func() ([]certificate, error)
+	}{
+		{"edge certificates", "edge", c.fetchEdgeCertificates},
+		{"custom certificates", "custom", c.fetchCustomCertificates},
+		{"custom hostnames", "custom_hostname", c.fetchCustomHostnameCertificates},
+		{"origin CA certificates", "origin_ca", c.fetchOriginCACertificates},
+	}
+
+	var total, expiringSoon int
+	var errs []string
+	for _, f := range fetchers {
+		certs, err := f.fetch()
+		if err != nil {
+			// Keep the previous series so expiry alerts don't resolve
+			// on a transient failure.
+			errs = append(errs, fmt.Sprintf("%s: %v", f.name, err))
+			continue
+		}
+
+		sourceLabels := prometheus.Labels{"zone_id": c.zoneID, "source": f.source}
+		c.metrics.CertificateExpiry.DeletePartialMatch(sourceLabels)
+		c.metrics.CertificateInfo.DeletePartialMatch(sourceLabels)
+
+		for _, cert := range certs {
+			c.metrics.CertificateInfo.WithLabelValues(c.zoneID, cert.source, cert.id, cert.hosts,
+				cert.status, cert.issuer, cert.validationMethod).Set(1)
+
+			if !cert.expiresOn.IsZero() {
+				c.metrics.CertificateExpiry.WithLabelValues(c.zoneID, cert.source, cert.id, cert.hosts).Set(float64(cert.expiresOn.Unix()))
+				if time.Until(cert.expiresOn) < 14*24*time.Hour {
+					expiringSoon++
+				}
+			}
+		}
+		total += len(certs)
+	}
+
+	log.Printf(" Certificates: %d | %d expiring within 14 days", total, expiringSoon)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (c *Collector) fetchEdgeCertificates() ([]certificate, error) {
+	packs, err := c.client.GetAll(fmt.Sprintf("/zones/%s/ssl/certificate_packs?status=all", c.zoneID))
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []certificate
+	for _, p := range packs {
+		pack := p.(map[string]interface{})
+		packID, _ := pack["id"].(string)
+		packStatus, _ := pack["status"].(string)
+		method, _ := pack["validation_method"].(string)
+		ca, _ := pack["certificate_authority"].(string)
+		hosts := joinHosts(pack["hosts"])
+
+		packCerts, _ := pack["certificates"].([]interface{})
+		if len(packCerts) == 0 {
+			// Pending packs have no issued certificate yet
+			certs = append(certs, certificate{
+				source: "edge", id: packID, hosts: hosts, status: packStatus,
+				issuer: ca, validationMethod: method,
+			})
+			continue
+		}
+
+		for _, pc := range packCerts {
+			cert := pc.(map[string]interface{})
+			id, _ := cert["id"].(string)
+			status, _ := cert["status"].(string)
+			issuer, _ := cert["issuer"].(string)
+			if issuer == "" {
+				issuer = ca
+			}
+			certs = append(certs, certificate{
+				source: "edge", id: id, hosts: hosts, status: status,
+				issuer: issuer, validationMethod: method,
+				expiresOn: parseAPITime(cert["expires_on"]),
+			})
+		}
+	}
+
+	return certs, nil
+}
+
+func (c *Collector) fetchCustomCertificates() ([]certificate, error) {
+	list, err := c.client.GetAll(fmt.Sprintf("/zones/%s/custom_certificates", c.zoneID))
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []certificate
+	for _, item := range list {
+		cert := item.(map[string]interface{})
+		id, _ := cert["id"].(string)
+		status, _ := cert["status"].(string)
+		issuer, _ := cert["issuer"].(string)
+		certs = append(certs, certificate{
+			source: "custom", id: id, hosts: joinHosts(cert["hosts"]), status: status,
+			issuer: issuer, validationMethod: "none",
+			expiresOn: parseAPITime(cert["expires_on"]),
+		})
+	}
+
+	return certs, nil
+}
+
+func (c *Collector) fetchCustomHostnameCertificates() ([]certificate, error) {
+	list, err := c.client.GetAll(fmt.Sprintf("/zones/%s/custom_hostnames", c.zoneID))
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []certificate
+	for _, item := range list {
+		hostname := item.(map[string]interface{})
+		name, _ := hostname["hostname"].(string)
+		ssl, ok := hostname["ssl"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id, _ := ssl["id"].(string)
+		status, _ := ssl["status"].(string)
+		method, _ := ssl["method"].(string)
+		issuer, _ := ssl["issuer"].(string)
+		if issuer == "" {
+			issuer, _ = ssl["certificate_authority"].(string)
+		}
+
+		expiresOn := parseAPITime(ssl["expires_on"])
+		if sslCerts, ok := ssl["certificates"].([]interface{}); ok && expiresOn.IsZero() {
+			for _, sc := range sslCerts {
+				cert := sc.(map[string]interface{})
+				if t := parseAPITime(cert["expires_on"]); !t.IsZero() && (expiresOn.IsZero() || t.Before(expiresOn)) {
+					expiresOn = t
+				}
+			}
+		}
+
+		certs = append(certs, certificate{
+			source: "custom_hostname", id: id, hosts: name, status: status,
+			issuer: issuer, validationMethod: method, expiresOn: expiresOn,
+		})
+	}
+
+	return certs, nil
+}
+
+func (c *Collector) fetchOriginCACertificates() ([]certificate, error) {
+	list, err := c.client.GetAll(fmt.Sprintf("/certificates?zone_id=%s", c.zoneID))
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []certificate
+	for _, item := range list {
+		cert := item.(map[string]interface{})
+		id, _ := cert["id"].(string)
+		expiresOn := parseAPITime(cert["expires_on"])
+
+		status := "active"
+		if !expiresOn.IsZero() && expiresOn.Before(time.Now()) {
+			status = "expired"
+		}
+
+		certs = append(certs, certificate{
+			source: "origin_ca", id: id, hosts: joinHosts(cert["hostnames"]), status: status,
+			issuer: "Cloudflare Origin CA", validationMethod: "none", expiresOn: expiresOn,
+		})
+	}
+
+	return certs, nil
+}
+
+func parseAPITime(v interface{}) time.Time {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return time.Time{}
+	}
+	for _, layout := range apiTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func joinHosts(v interface{}) string {
+	list, _ := v.([]interface{})
+	hosts := make([]string, 0, len(list))
+	for _, h := range list {
+		if host, ok := h.(string); ok {
+			hosts = append(hosts, host)
+		}
+	}
+	return strings.Join(hosts, ",")
+}
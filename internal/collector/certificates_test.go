@@ -0,0 +1,31 @@
+package collector
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseAPITime(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  time.Time
+	}{
+		{"RFC 3339", "2026-03-01T12:30:00Z", time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)},
+		{"RFC 3339 with fraction", "2026-03-01T12:30:00.123456Z", time.Date(2026, 3, 1, 12, 30, 0, 123456000, time.UTC)},
+		{"RFC 3339 with offset", "2026-03-01T14:30:00+02:00", time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)},
+		{"Go time string", "2026-03-01 12:30:00 +0000 UTC", time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)},
+		{"empty", "", time.Time{}},
+		{"not a string", 1700000000.0, time.Time{}},
+		{"nil", nil, time.Time{}},
+		{"garbage", "yesterday", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseAPITime(tt.value); !got.Equal(tt.want) {
+				t.Errorf("parseAPITime(%v) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
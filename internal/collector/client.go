@@ -0,0 +1,12 @@
+package collector
+
+func (c *Collector) CollectClientMetrics() error {
+	breakdowns := []trafficBreakdown{
+		{"Method", "clientRequestHTTPMethodName", c.metrics.MethodRequests, c.metrics.MethodBytes, nil},
+		{"Device type", "clientDeviceType", c.metrics.DeviceTypeRequests, c.metrics.DeviceTypeBytes, nil},
+		{"Browser", "userAgentBrowser", c.metrics.BrowserRequests, c.metrics.BrowserBytes, nil},
+		{"OS", "userAgentOS", c.metrics.OSRequests, c.metrics.OSBytes, nil},
+	}
+
+	return c.collectTrafficBreakdowns(breakdowns)
+}
@@ -0,0 +1,141 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"cloudflare-exporter/internal/config"
+)
+
+type coloLocation = config.ColoLocation
+
+// mergeColoLocations returns the built-in colo mapping with the given
+// entries added or overridden.
+func mergeColoLocations(overrides map[string]coloLocation) map[string]coloLocation {
+	if len(overrides) == 0 {
+		return defaultColoLocations
+	}
+
+	locations := make(map[string]coloLocation, len(defaultColoLocations)+len(overrides))
+	for code, loc := range defaultColoLocations {
+		locations[code] = loc
+	}
+	for code, loc := range overrides {
+		locations[code] = loc
+	}
+
+	return locations
+}
+
+func (c *Collector) CollectColoMetrics() error {
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	query := fmt.Sprintf(`{
+		viewer {
+			zones(filter: {zoneTag: "%s"}) {
+				httpRequestsAdaptiveGroups(
+					limit: 10000
+					filter: {datetime_geq: "%s", datetime_leq: "%s"}
+				) {
+					count
+					sum {
+						edgeResponseBytes
+					}
+					avg {
+						edgeTimeToFirstByteMs
+					}
+					dimensions {
+						coloCode
+						edgeResponseStatus
+					}
+				}
+			}
+		}
+	}`, c.zoneID, since.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	result, err := c.client.ExecuteQuery(query)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return c.processColoMetrics(result)
+}
+
+func (c *Collector) processColoMetrics(data map[string]interface{}) error {
+	zones := data["data"].(map[string]interface{})["viewer"].(map[string]interface{})["zones"].([]interface{})
+	if len(zones) == 0 {
+		return fmt.Errorf("no zones found")
+	}
+
+	zone := zones[0].(map[string]interface{})
+	groups, ok := zone["httpRequestsAdaptiveGroups"].([]interface{})
+	if !ok {
+		return fmt.Errorf("colo metrics not available")
+	}
+
+	reqMap := make(map[string]int64)
+	bwMap := make(map[string]int64)
+	errorMap := make(map[string]int64)
+	latencyMap := make(map[string]float64)
+
+	for _, g := range groups {
+		group := g.(map[string]interface{})
+		count := int64(group["count"].(float64))
+
+		dims, ok := group["dimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		colo, _ := dims["coloCode"].(string)
+		if colo == "" {
+			continue
+		}
+
+		reqMap[colo] += count
+		if status, ok := dims["edgeResponseStatus"].(float64); ok && status >= 500 {
+			errorMap[colo] += count
+		}
+		if sum, ok := group["sum"].(map[string]interface{}); ok {
+			if bw, ok := sum["edgeResponseBytes"].(float64); ok {
+				bwMap[colo] += int64(bw)
+			}
+		}
+		// Weight each group's average by its request count so the
+		// per-colo average covers all status codes.
+		if avg, ok := group["avg"].(map[string]interface{}); ok {
+			if ttfb, ok := avg["edgeTimeToFirstByteMs"].(float64); ok {
+				latencyMap[colo] += ttfb * float64(count)
+			}
+		}
+	}
+
+	c.metrics.ColoRequests.Reset()
+	c.metrics.ColoBytes.Reset()
+	c.metrics.ColoErrorRate.Reset()
+	c.metrics.ColoEdgeLatency.Reset()
+
+	for colo, reqs := range reqMap {
+		errorRate := float64(0)
+		avgLatency := float64(0)
+		if reqs > 0 {
+			errorRate = float64(errorMap[colo]) / float64(reqs) * 100
+			avgLatency = latencyMap[colo] / float64(reqs)
+		}
+
+		c.metrics.ColoRequests.WithLabelValues(c.zoneID, colo).Set(float64(reqs))
+		c.metrics.ColoBytes.WithLabelValues(c.zoneID, colo).Set(float64(bwMap[colo]))
+		c.metrics.ColoErrorRate.WithLabelValues(c.zoneID, colo).Set(errorRate)
+		c.metrics.ColoEdgeLatency.WithLabelValues(c.zoneID, colo).Set(avgLatency)
+
+		if loc, ok := c.coloLocations[colo]; ok {
+			c.metrics.ColoInfo.WithLabelValues(colo, loc.City, loc.Region, loc.Continent).Set(1)
+		}
+	}
+
+	log.Printf(" Colo: %d data centers", len(reqMap))
+
+	return nil
+}
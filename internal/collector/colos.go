@@ -0,0 +1,87 @@
+package collector
+
+// defaultColoLocations maps Cloudflare data center codes to their location.
+// Entries can be added or overridden via COLO_MAPPING_FILE.
+var defaultColoLocations = map[string]coloLocation{
+	"AMS": {City: "Amsterdam", Region: "NL", Continent: "EU"},
+	"ARN": {City: "Stockholm", Region: "SE", Continent: "EU"},
+	"ATH": {City: "Athens", Region: "GR", Continent: "EU"},
+	"BCN": {City: "Barcelona", Region: "ES", Continent: "EU"},
+	"BRU": {City: "Brussels", Region: "BE", Continent: "EU"},
+	"BUD": {City: "Budapest", Region: "HU", Continent: "EU"},
+	"CDG": {City: "Paris", Region: "FR", Continent: "EU"},
+	"CPH": {City: "Copenhagen", Region: "DK", Continent: "EU"},
+	"DUB": {City: "Dublin", Region: "IE", Continent: "EU"},
+	"DUS": {City: "Düsseldorf", Region: "DE", Continent: "EU"},
+	"FRA": {City: "Frankfurt", Region: "DE", Continent: "EU"},
+	"HAM": {City: "Hamburg", Region: "DE", Continent: "EU"},
+	"HEL": {City: "Helsinki", Region: "FI", Continent: "EU"},
+	"IST": {City: "Istanbul", Region: "TR", Continent: "EU"},
+	"KBP": {City: "Kyiv", Region: "UA", Continent: "EU"},
+	"LHR": {City: "London", Region: "GB", Continent: "EU"},
+	"LIS": {City: "Lisbon", Region: "PT", Continent: "EU"},
+	"MAD": {City: "Madrid", Region: "ES", Continent: "EU"},
+	"MAN": {City: "Manchester", Region: "GB", Continent: "EU"},
+	"MRS": {City: "Marseille", Region: "FR", Continent: "EU"},
+	"MUC": {City: "Munich", Region: "DE", Continent: "EU"},
+	"MXP": {City: "Milan", Region: "IT", Continent: "EU"},
+	"OSL": {City: "Oslo", Region: "NO", Continent: "EU"},
+	"OTP": {City: "Bucharest", Region: "RO", Continent: "EU"},
+	"PRG": {City: "Prague", Region: "CZ", Continent: "EU"},
+	"SOF": {City: "Sofia", Region: "BG", Continent: "EU"},
+	"VIE": {City: "Vienna", Region: "AT", Continent: "EU"},
+	"WAW": {City: "Warsaw", Region: "PL", Continent: "EU"},
+	"ZRH": {City: "Zurich", Region: "CH", Continent: "EU"},
+	"ATL": {City: "Atlanta", Region: "US", Continent: "NA"},
+	"BOS": {City: "Boston", Region: "US", Continent: "NA"},
+	"DEN": {City: "Denver", Region: "US", Continent: "NA"},
+	"DFW": {City: "Dallas", Region: "US", Continent: "NA"},
+	"EWR": {City: "Newark", Region: "US", Continent: "NA"},
+	"IAD": {City: "Ashburn", Region: "US", Continent: "NA"},
+	"IAH": {City: "Houston", Region: "US", Continent: "NA"},
+	"LAX": {City: "Los Angeles", Region: "US", Continent: "NA"},
+	"MIA": {City: "Miami", Region: "US", Continent: "NA"},
+	"MSP": {City: "Minneapolis", Region: "US", Continent: "NA"},
+	"ORD": {City: "Chicago", Region: "US", Continent: "NA"},
+	"PDX": {City: "Portland", Region: "US", Continent: "NA"},
+	"PHX": {City: "Phoenix", Region: "US", Continent: "NA"},
+	"SEA": {City: "Seattle", Region: "US", Continent: "NA"},
+	"SJC": {City: "San Jose", Region: "US", Continent: "NA"},
+	"SLC": {City: "Salt Lake City", Region: "US", Continent: "NA"},
+	"YUL": {City: "Montréal", Region: "CA", Continent: "NA"},
+	"YVR": {City: "Vancouver", Region: "CA", Continent: "NA"},
+	"YYZ": {City: "Toronto", Region: "CA", Continent: "NA"},
+	"MEX": {City: "Mexico City", Region: "MX", Continent: "NA"},
+	"QRO": {City: "Querétaro", Region: "MX", Continent: "NA"},
+	"BOG": {City: "Bogotá", Region: "CO", Continent: "SA"},
+	"EZE": {City: "Buenos Aires", Region: "AR", Continent: "SA"},
+	"GRU": {City: "São Paulo", Region: "BR", Continent: "SA"},
+	"GIG": {City: "Rio de Janeiro", Region: "BR", Continent: "SA"},
+	"LIM": {City: "Lima", Region: "PE", Continent: "SA"},
+	"SCL": {City: "Santiago", Region: "CL", Continent: "SA"},
+	"BKK": {City: "Bangkok", Region: "TH", Continent: "AS"},
+	"BOM": {City: "Mumbai", Region: "IN", Continent: "AS"},
+	"CGK": {City: "Jakarta", Region: "ID", Continent: "AS"},
+	"DEL": {City: "New Delhi", Region: "IN", Continent: "AS"},
+	"DXB": {City: "Dubai", Region: "AE", Continent: "AS"},
+	"HKG": {City: "Hong Kong", Region: "HK", Continent: "AS"},
+	"ICN": {City: "Seoul", Region: "KR", Continent: "AS"},
+	"KIX": {City: "Osaka", Region: "JP", Continent: "AS"},
+	"KUL": {City: "Kuala Lumpur", Region: "MY", Continent: "AS"},
+	"MAA": {City: "Chennai", Region: "IN", Continent: "AS"},
+	"MNL": {City: "Manila", Region: "PH", Continent: "AS"},
+	"NRT": {City: "Tokyo", Region: "JP", Continent: "AS"},
+	"SIN": {City: "Singapore", Region: "SG", Continent: "AS"},
+	"TLV": {City: "Tel Aviv", Region: "IL", Continent: "AS"},
+	"TPE": {City: "Taipei", Region: "TW", Continent: "AS"},
+	"AKL": {City: "Auckland", Region: "NZ", Continent: "OC"},
+	"BNE": {City: "Brisbane", Region: "AU", Continent: "OC"},
+	"MEL": {City: "Melbourne", Region: "AU", Continent: "OC"},
+	"PER": {City: "Perth", Region: "AU", Continent: "OC"},
+	"SYD": {City: "Sydney", Region: "AU", Continent: "OC"},
+	"CAI": {City: "Cairo", Region: "EG", Continent: "AF"},
+	"CPT": {City: "Cape Town", Region: "ZA", Continent: "AF"},
+	"JNB": {City: "Johannesburg", Region: "ZA", Continent: "AF"},
+	"LOS": {City: "Lagos", Region: "NG", Continent: "AF"},
+	"NBO": {City: "Nairobi", Region: "KE", Continent: "AF"},
+}
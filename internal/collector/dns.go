@@ -0,0 +1,124 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+func (c *Collector) CollectDNSMetrics() error {
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	query := fmt.Sprintf(`{
+		viewer {
+			zones(filter: {zoneTag: "%[1]s"}) {
+				queries: dnsAnalyticsAdaptiveGroups(
+					limit: 10000
+					filter: {datetime_geq: "%[2]s", datetime_leq: "%[3]s"}
+				) {
+					count
+					dimensions {
+						queryName
+						queryType
+						responseCode
+					}
+				}
+				latency: dnsAnalyticsAdaptiveGroups(
+					limit: 1
+					filter: {datetime_geq: "%[2]s", datetime_leq: "%[3]s"}
+				) {
+					quantiles {
+						processingTimeUsP50
+						processingTimeUsP90
+						processingTimeUsP99
+					}
+				}
+			}
+		}
+	}`, c.zoneID, since.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	result, err := c.client.ExecuteQuery(query)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return c.processDNSMetrics(result)
+}
+
+func (c *Collector) processDNSMetrics(data map[string]interface{}) error {
+	zones := data["data"].(map[string]interface{})["viewer"].(map[string]interface{})["zones"].([]interface{})
+	if len(zones) == 0 {
+		return fmt.Errorf("no zones found")
+	}
+
+	zone := zones[0].(map[string]interface{})
+	groups, ok := zone["queries"].([]interface{})
+	if !ok {
+		return fmt.Errorf("DNS analytics not available")
+	}
+
+	var totalQueries int64
+	typeMap := make(map[string]int64)
+	rcodeMap := make(map[string]int64)
+	nameMap := make(map[string]int64)
+
+	for _, g := range groups {
+		group := g.(map[string]interface{})
+		count := int64(group["count"].(float64))
+		totalQueries += count
+
+		dims, ok := group["dimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if qtype, ok := dims["queryType"].(string); ok && qtype != "" {
+			typeMap[qtype] += count
+		}
+		if rcode, ok := dims["responseCode"].(string); ok && rcode != "" {
+			rcodeMap[rcode] += count
+		}
+		if name, ok := dims["queryName"].(string); ok && name != "" {
+			nameMap[name] += count
+		}
+	}
+
+	c.metrics.DNSQueries.WithLabelValues(c.zoneID).Set(float64(totalQueries))
+
+	c.metrics.DNSQueryType.Reset()
+	for qtype, count := range typeMap {
+		c.metrics.DNSQueryType.WithLabelValues(c.zoneID, qtype).Set(float64(count))
+	}
+
+	c.metrics.DNSResponseCode.Reset()
+	for rcode, count := range rcodeMap {
+		c.metrics.DNSResponseCode.WithLabelValues(c.zoneID, rcode).Set(float64(count))
+	}
+
+	topNames, other := getTopNWithOther(nameMap, c.cfg.DNSQueryNameTopN, nil)
+	c.metrics.DNSQueryName.Reset()
+	for name, count := range topNames {
+		c.metrics.DNSQueryName.WithLabelValues(c.zoneID, name).Set(float64(count))
+	}
+	if other > 0 {
+		c.metrics.DNSQueryName.WithLabelValues(c.zoneID, otherLabel).Set(float64(other))
+	}
+
+	if latency, ok := zone["latency"].([]interface{}); ok && len(latency) > 0 {
+		group := latency[0].(map[string]interface{})
+		if quantiles, ok := group["quantiles"].(map[string]interface{}); ok {
+			for quantile, suffix := range analyticsQuantiles {
+				// processing time is reported in microseconds
+				if v, ok := quantiles["processingTimeUs"+suffix].(float64); ok {
+					c.metrics.DNSResponseTime.WithLabelValues(c.zoneID, quantile).Set(v / 1000)
+				}
+			}
+		}
+	}
+
+	log.Printf(" DNS: %d queries | %d types | NXDOMAIN:%d",
+		totalQueries, len(typeMap), rcodeMap["NXDOMAIN"])
+
+	return nil
+}
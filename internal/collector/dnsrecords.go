@@ -0,0 +1,75 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"net/netip"
+	"strconv"
+)
+
+func (c *Collector) CollectDNSRecordMetrics() error {
+	records, err := c.client.GetAll(fmt.Sprintf("/zones/%s/dns_records", c.zoneID))
+	if err != nil {
+		return fmt.Errorf("failed to list DNS records: %w", err)
+	}
+
+	type typeProxied struct {
+		recordType string
+		proxied    string
+	}
+
+	countMap := make(map[typeProxied]int64)
+	var orphaned int
+
+	c.metrics.DNSRecords.Reset()
+	c.metrics.DNSRecordInfo.Reset()
+	c.metrics.DNSRecordDecommissioned.Reset()
+
+	for _, r := range records {
+		record := r.(map[string]interface{})
+		id, _ := record["id"].(string)
+		name, _ := record["name"].(string)
+		recordType, _ := record["type"].(string)
+		content, _ := record["content"].(string)
+		proxied, _ := record["proxied"].(bool)
+		ttl, _ := record["ttl"].(float64)
+
+		proxiedStr := strconv.FormatBool(proxied)
+		countMap[typeProxied{recordType, proxiedStr}]++
+
+		if c.cfg.DNSRecordInfo {
+			c.metrics.DNSRecordInfo.WithLabelValues(c.zoneID, id, name, recordType, content,
+				proxiedStr, strconv.Itoa(int(ttl))).Set(1)
+		}
+
+		if (recordType == "A" || recordType == "AAAA") && c.inDecommissionedRange(content) {
+			c.metrics.DNSRecordDecommissioned.WithLabelValues(c.zoneID, name, recordType, content).Set(1)
+			orphaned++
+		}
+	}
+
+	for tp, count := range countMap {
+		c.metrics.DNSRecords.WithLabelValues(c.zoneID, tp.recordType, tp.proxied).Set(float64(count))
+	}
+
+	log.Printf(" DNS records: %d | %d pointing at decommissioned ranges", len(records), orphaned)
+
+	return nil
+}
+
+func (c *Collector) inDecommissionedRange(content string) bool {
+	if len(c.cfg.DNSDecommissionedRanges) == 0 {
+		return false
+	}
+
+	addr, err := netip.ParseAddr(content)
+	if err != nil {
+		return false
+	}
+	for _, prefix := range c.cfg.DNSDecommissionedRanges {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -102,8 +102,13 @@ func (c *Collector) processFirewallMetrics(data map[string]interface{}) error {
 	for source, count := range sourceMap {
 		c.metrics.FirewallSource.WithLabelValues(c.zoneID, source).Set(float64(count))
 	}
+	rules := c.ruleInfos()
+	c.metrics.FirewallRuleInfo.Reset()
 	for ruleID, count := range getTopN(ruleIDMap, 50) {
 		c.metrics.FirewallRuleID.WithLabelValues(c.zoneID, ruleID).Set(float64(count))
+		if info, ok := rules[ruleID]; ok {
+			c.metrics.FirewallRuleInfo.WithLabelValues(c.zoneID, ruleID, info.Description, info.Ruleset, info.Phase).Set(1)
+		}
 	}
 	for host, count := range getTopN(hostMap, 20) {
 		c.metrics.FirewallHost.WithLabelValues(c.zoneID, host).Set(float64(count))
@@ -154,4 +159,25 @@ func getTopN(m map[string]int64, n int) map[string]int64 {
 	}
 
 	return result
-}
\ No newline at end of file
+}
+
+// getTopNWithOther works like getTopN but always keeps the keys in keep,
+// and returns the sum of everything left out so it can be exported as an
+// "other" series.
+func getTopNWithOther(m map[string]int64, n int, keep map[string]bool) (map[string]int64, int64) {
+	result := getTopN(m, n)
+	for k := range keep {
+		if v, ok := m[k]; ok {
+			result[k] = v
+		}
+	}
+
+	var other int64
+	for k, v := range m {
+		if _, ok := result[k]; !ok {
+			other += v
+		}
+	}
+
+	return result, other
+}
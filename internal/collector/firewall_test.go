@@ -0,0 +1,66 @@
+package collector
+
+import "testing"
+
+func TestGetTopNWithOther(t *testing.T) {
+	counts := map[string]int64{"a": 50, "b": 30, "c": 10, "d": 5, "e": 1}
+
+	tests := []struct {
+		name      string
+		n         int
+		keep      map[string]bool
+		want      map[string]int64
+		wantOther int64
+	}{
+		{
+			name:      "top two",
+			n:         2,
+			want:      map[string]int64{"a": 50, "b": 30},
+			wantOther: 16,
+		},
+		{
+			name:      "keep adds to the top N",
+			n:         2,
+			keep:      map[string]bool{"e": true},
+			want:      map[string]int64{"a": 50, "b": 30, "e": 1},
+			wantOther: 15,
+		},
+		{
+			name:      "keep entry already in the top N",
+			n:         2,
+			keep:      map[string]bool{"a": true},
+			want:      map[string]int64{"a": 50, "b": 30},
+			wantOther: 16,
+		},
+		{
+			name:      "keep entry without data",
+			n:         1,
+			keep:      map[string]bool{"missing": true},
+			want:      map[string]int64{"a": 50},
+			wantOther: 46,
+		},
+		{
+			name:      "n larger than input",
+			n:         10,
+			want:      counts,
+			wantOther: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, other := getTopNWithOther(counts, tt.n, tt.keep)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("got[%q] = %d, want %d", k, got[k], v)
+				}
+			}
+			if other != tt.wantOther {
+				t.Errorf("other = %d, want %d", other, tt.wantOther)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// firewallDimensionFields maps FIREWALL_DIMENSIONS label names to the
+// firewallEventsAdaptiveGroups dimensions they are read from.
+var firewallDimensionFields = map[string]string{
+	"action":  "action",
+	"source":  "source",
+	"rule_id": "ruleId",
+	"host":    "clientRequestHTTPHost",
+	"country": "clientCountryName",
+	"method":  "clientRequestHTTPMethodName",
+	"path":    "clientRequestPath",
+}
+
+// seriesKeySeparator joins label values into a single map key; it cannot
+// appear in any of the dimension values.
+const seriesKeySeparator = "\x00"
+
+func (c *Collector) CollectFirewallBreakdownMetrics() error {
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	fields := make([]string, len(c.cfg.FirewallDimensions))
+	for i, d := range c.cfg.FirewallDimensions {
+		fields[i] = firewallDimensionFields[d]
+	}
+
+	query := fmt.Sprintf(`{
+		viewer {
+			zones(filter: {zoneTag: "%s"}) {
+				firewallEventsAdaptiveGroups(
+					limit: 10000
+					filter: {datetime_geq: "%s", datetime_leq: "%s"}
+				) {
+					count
+					dimensions {
+						%s
+					}
+				}
+			}
+		}
+	}`, c.zoneID, since.Format(time.RFC3339), now.Format(time.RFC3339), strings.Join(fields, "\n\t\t\t\t\t\t"))
+
+	result, err := c.client.ExecuteQuery(query)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return c.processFirewallBreakdownMetrics(result, fields)
+}
+
+func (c *Collector) processFirewallBreakdownMetrics(data map[string]interface{}, fields []string) error {
+	zones := data["data"].(map[string]interface{})["viewer"].(map[string]interface{})["zones"].([]interface{})
+	if len(zones) == 0 {
+		return fmt.Errorf("no zones found")
+	}
+
+	zone := zones[0].(map[string]interface{})
+	groups, ok := zone["firewallEventsAdaptiveGroups"].([]interface{})
+	if !ok || len(groups) == 0 {
+		return fmt.Errorf("firewall metrics not available (may require Pro/Business plan)")
+	}
+
+	seriesMap := make(map[string]int64)
+	for _, g := range groups {
+		group := g.(map[string]interface{})
+		count := int64(group["count"].(float64))
+
+		dims, ok := group["dimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		values := make([]string, len(fields))
+		for i, field := range fields {
+			values[i] = dimensionString(dims[field])
+		}
+		seriesMap[strings.Join(values, seriesKeySeparator)] += count
+	}
+
+	top := getTopN(seriesMap, c.cfg.FirewallSeriesLimit)
+
+	c.metrics.FirewallEventsBreakdown.Reset()
+	for key, count := range top {
+		labels := append([]string{c.zoneID}, strings.Split(key, seriesKeySeparator)...)
+		c.metrics.FirewallEventsBreakdown.WithLabelValues(labels...).Set(float64(count))
+	}
+
+	log.Printf(" Firewall breakdown: %d of %d series by %s",
+		len(top), len(seriesMap), strings.Join(c.cfg.FirewallDimensions, ","))
+
+	return nil
+}
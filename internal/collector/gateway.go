@@ -0,0 +1,206 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// gatewayResolverDecisions maps Gateway resolver decision codes to their
+// name and whether the query was allowed, blocked or overridden.
+var gatewayResolverDecisions = map[int]struct {
+	reason   string
+	decision string
+}{
+	1:  {"allowedByQueryName", "allowed"},
+	2:  {"blockedByQueryName", "blocked"},
+	3:  {"blockedByCategory", "blocked"},
+	4:  {"allowedOnNoLocation", "allowed"},
+	5:  {"allowedOnNoPolicyMatch", "allowed"},
+	6:  {"blockedAlwaysCategory", "blocked"},
+	7:  {"overrideForSafeSearch", "overridden"},
+	8:  {"overrideApplied", "overridden"},
+	9:  {"blockedRule", "blocked"},
+	10: {"allowedRule", "allowed"},
+}
+
+func (c *Collector) CollectGatewayMetrics() error {
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	selection := fmt.Sprintf(`gatewayResolverQueriesAdaptiveGroups(
+					limit: 10000
+					filter: {datetime_geq: "%[1]s", datetime_leq: "%[2]s"}
+				) {
+					count
+					dimensions {
+						resolverDecision
+						categoryNames
+						queryName
+					}
+				}
+				gatewayL7RequestsAdaptiveGroups(
+					limit: 10000
+					filter: {datetime_geq: "%[1]s", datetime_leq: "%[2]s"}
+				) {
+					count
+					dimensions {
+						action
+						policyId
+					}
+				}`, since.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	account, err := c.client.ExecuteAccountQuery(c.cfg.AccountID, selection)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	policyNames, err := c.fetchGatewayPolicyNames()
+	if err != nil {
+		log.Printf("  Gateway policy names: %v", err)
+	}
+
+	return c.processGatewayMetrics(account, policyNames)
+}
+
+// fetchGatewayPolicyNames maps Gateway rule IDs to their names.
+func (c *Collector) fetchGatewayPolicyNames() (map[string]string, error) {
+	result, err := c.client.Get(fmt.Sprintf("/accounts/%s/gateway/rules", c.cfg.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string)
+	rules, _ := result.([]interface{})
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		id, _ := rule["id"].(string)
+		name, _ := rule["name"].(string)
+		names[id] = name
+	}
+
+	return names, nil
+}
+
+func (c *Collector) processGatewayMetrics(account map[string]interface{}, policyNames map[string]string) error {
+	dnsGroups, ok := account["gatewayResolverQueriesAdaptiveGroups"].([]interface{})
+	if !ok {
+		return fmt.Errorf("gateway metrics not available")
+	}
+
+	type decisionValue struct {
+		decision string
+		value    string
+	}
+
+	accountID := c.cfg.AccountID
+	decisionMap := make(map[decisionValue]int64)
+	categoryMap := make(map[decisionValue]int64)
+	domainMap := make(map[string]int64)
+	domainDecisions := make(map[string]map[string]int64)
+	var totalQueries, blockedQueries int64
+
+	for _, g := range dnsGroups {
+		group := g.(map[string]interface{})
+		count := int64(group["count"].(float64))
+		totalQueries += count
+
+		dims, ok := group["dimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		code, _ := dims["resolverDecision"].(float64)
+		decision, reason := "unknown", "unknown"
+		if d, ok := gatewayResolverDecisions[int(code)]; ok {
+			decision, reason = d.decision, d.reason
+		}
+		if decision == "blocked" {
+			blockedQueries += count
+		}
+		decisionMap[decisionValue{decision, reason}] += count
+
+		for _, category := range gatewayCategories(dims["categoryNames"]) {
+			categoryMap[decisionValue{decision, category}] += count
+		}
+
+		if name, ok := dims["queryName"].(string); ok && name != "" {
+			domainMap[name] += count
+			if domainDecisions[name] == nil {
+				domainDecisions[name] = make(map[string]int64)
+			}
+			domainDecisions[name][decision] += count
+		}
+	}
+
+	c.metrics.GatewayDNSQueries.Reset()
+	for dv, count := range decisionMap {
+		c.metrics.GatewayDNSQueries.WithLabelValues(accountID, dv.decision, dv.value).Set(float64(count))
+	}
+
+	c.metrics.GatewayDNSCategory.Reset()
+	for dv, count := range categoryMap {
+		c.metrics.GatewayDNSCategory.WithLabelValues(accountID, dv.decision, dv.value).Set(float64(count))
+	}
+
+	topDomains, other := getTopNWithOther(domainMap, c.cfg.GatewayDomainLimit, nil)
+	c.metrics.GatewayDNSDomain.Reset()
+	for domain := range topDomains {
+		for decision, count := range domainDecisions[domain] {
+			c.metrics.GatewayDNSDomain.WithLabelValues(accountID, domain, decision).Set(float64(count))
+		}
+	}
+	if other > 0 {
+		c.metrics.GatewayDNSDomain.WithLabelValues(accountID, otherLabel, otherLabel).Set(float64(other))
+	}
+
+	c.metrics.GatewayHTTPRequests.Reset()
+	var totalHTTP int64
+	if httpGroups, ok := account["gatewayL7RequestsAdaptiveGroups"].([]interface{}); ok {
+		for _, g := range httpGroups {
+			group := g.(map[string]interface{})
+			count := group["count"].(float64)
+			totalHTTP += int64(count)
+
+			dims, ok := group["dimensions"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			action := dimensionString(dims["action"])
+			policyID, _ := dims["policyId"].(string)
+			policy := policyNames[policyID]
+			if policy == "" {
+				policy = policyID
+			}
+
+			c.metrics.GatewayHTTPRequests.WithLabelValues(accountID, action, policy).Add(count)
+		}
+	}
+
+	log.Printf(" Gateway: %d DNS queries (%d blocked) | %d HTTP requests",
+		totalQueries, blockedQueries, totalHTTP)
+
+	return nil
+}
+
+// gatewayCategories accepts category names either as a list or as a
+// comma-separated string.
+func gatewayCategories(v interface{}) []string {
+	var categories []string
+	switch val := v.(type) {
+	case []interface{}:
+		for _, item := range val {
+			if s := dimensionString(item); s != "" {
+				categories = append(categories, s)
+			}
+		}
+	case string:
+		for _, s := range strings.Split(val, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				categories = append(categories, s)
+			}
+		}
+	}
+	return categories
+}
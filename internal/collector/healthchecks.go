@@ -0,0 +1,154 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// healthCheckStatuses are the states reported by the Health Checks API.
+var healthCheckStatuses = []string{"healthy", "unhealthy", "suspended", "unknown"}
+
+func (c *Collector) CollectHealthCheckMetrics() error {
+	checks, err := c.client.GetAll(fmt.Sprintf("/zones/%s/healthchecks", c.zoneID))
+	if err != nil {
+		return fmt.Errorf("failed to list health checks: %w", err)
+	}
+
+	c.metrics.HealthCheckStatus.Reset()
+	c.metrics.HealthCheckFailureThreshold.Reset()
+
+	var unhealthy int
+	for _, hc := range checks {
+		check := hc.(map[string]interface{})
+		name, _ := check["name"].(string)
+		status, _ := check["status"].(string)
+		threshold, _ := check["consecutive_fails"].(float64)
+
+		if status == "unhealthy" {
+			unhealthy++
+		}
+		for _, s := range healthCheckStatuses {
+			value := float64(0)
+			if s == status {
+				value = 1
+			}
+			c.metrics.HealthCheckStatus.WithLabelValues(c.zoneID, name, s).Set(value)
+		}
+		c.metrics.HealthCheckFailureThreshold.WithLabelValues(c.zoneID, name).Set(threshold)
+	}
+
+	log.Printf(" Health checks: %d | %d unhealthy", len(checks), unhealthy)
+
+	if len(checks) == 0 {
+		c.metrics.HealthCheckRTT.Reset()
+		c.metrics.HealthCheckConsecutiveFailures.Reset()
+		return nil
+	}
+
+	return c.collectHealthCheckAnalytics()
+}
+
+func (c *Collector) collectHealthCheckAnalytics() error {
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+	recent := now.Add(-1 * time.Hour)
+
+	query := fmt.Sprintf(`{
+		viewer {
+			zones(filter: {zoneTag: "%s"}) {
+				healthCheckEventsAdaptiveGroups(
+					limit: 10000
+					filter: {datetime_geq: "%s", datetime_leq: "%s"}
+				) {
+					avg {
+						rttMs
+					}
+					dimensions {
+						healthCheckName
+						region
+					}
+				}
+				healthCheckEventsAdaptive(
+					limit: 10000
+					filter: {datetime_geq: "%s", datetime_leq: "%s"}
+					orderBy: [datetime_DESC]
+				) {
+					healthCheckName
+					region
+					healthStatus
+				}
+			}
+		}
+	}`, c.zoneID,
+		since.Format(time.RFC3339), now.Format(time.RFC3339),
+		recent.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	result, err := c.client.ExecuteQuery(query)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	zones := result["data"].(map[string]interface{})["viewer"].(map[string]interface{})["zones"].([]interface{})
+	if len(zones) == 0 {
+		return fmt.Errorf("no zones found")
+	}
+	zone := zones[0].(map[string]interface{})
+
+	c.metrics.HealthCheckRTT.Reset()
+	if groups, ok := zone["healthCheckEventsAdaptiveGroups"].([]interface{}); ok {
+		for _, g := range groups {
+			group := g.(map[string]interface{})
+			dims, ok := group["dimensions"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := dims["healthCheckName"].(string)
+			region, _ := dims["region"].(string)
+
+			if avg, ok := group["avg"].(map[string]interface{}); ok {
+				if rtt, ok := avg["rttMs"].(float64); ok {
+					c.metrics.HealthCheckRTT.WithLabelValues(c.zoneID, name, region).Set(rtt)
+				}
+			}
+		}
+	}
+
+	type checkRegion struct {
+		name   string
+		region string
+	}
+
+	// Events are ordered newest first, so counting stops at the first
+	// healthy event seen for each health check and region.
+	failures := make(map[checkRegion]int)
+	done := make(map[checkRegion]bool)
+	if events, ok := zone["healthCheckEventsAdaptive"].([]interface{}); ok {
+		for _, e := range events {
+			event := e.(map[string]interface{})
+			name, _ := event["healthCheckName"].(string)
+			region, _ := event["region"].(string)
+			status, _ := event["healthStatus"].(string)
+
+			key := checkRegion{name, region}
+			if done[key] {
+				continue
+			}
+			if status == "unhealthy" {
+				failures[key]++
+				continue
+			}
+			if _, ok := failures[key]; !ok {
+				failures[key] = 0
+			}
+			done[key] = true
+		}
+	}
+
+	c.metrics.HealthCheckConsecutiveFailures.Reset()
+	for key, count := range failures {
+		c.metrics.HealthCheckConsecutiveFailures.WithLabelValues(c.zoneID, key.name, key.region).Set(float64(count))
+	}
+
+	return nil
+}
@@ -0,0 +1,278 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+type lbPool struct {
+	name    string
+	origins map[string]string // address -> origin name
+}
+
+type lbPoolHealth struct {
+	lbName  string
+	pool    string
+	region  string
+	healthy bool
+}
+
+type lbOriginHealth struct {
+	lbName  string
+	pool    string
+	origin  string
+	region  string
+	healthy bool
+	rttMs   float64
+	hasRTT  bool
+}
+
+func (c *Collector) CollectLoadBalancerMetrics() error {
+	result, err := c.client.Get(fmt.Sprintf("/zones/%s/load_balancers", c.zoneID))
+	if err != nil {
+		return fmt.Errorf("failed to list load balancers: %w", err)
+	}
+
+	loadBalancers, _ := result.([]interface{})
+	if len(loadBalancers) == 0 {
+		// Clear series left over from load balancers that were deleted
+		c.metrics.LBPoolHealthy.Reset()
+		c.metrics.LBOriginHealthy.Reset()
+		c.metrics.LBOriginRTT.Reset()
+		c.metrics.LBSteeringRequests.Reset()
+		c.metrics.LBOriginRequests.Reset()
+		return nil
+	}
+
+	// Pools are account resources; without an account ID only the
+	// analytics below can be collected.
+	if c.cfg.AccountID != "" {
+		if err := c.collectPoolHealth(loadBalancers); err != nil {
+			log.Printf("  Load balancer pool health: %v", err)
+		}
+	}
+
+	return c.collectLoadBalancerAnalytics()
+}
+
+func (c *Collector) collectPoolHealth(loadBalancers []interface{}) error {
+	poolList, err := c.client.GetAll(fmt.Sprintf("/accounts/%s/load_balancers/pools", c.cfg.AccountID))
+	if err != nil {
+		return fmt.Errorf("failed to list pools: %w", err)
+	}
+
+	pools := make(map[string]lbPool)
+	for _, p := range poolList {
+		pool := p.(map[string]interface{})
+		id, _ := pool["id"].(string)
+		name, _ := pool["name"].(string)
+
+		origins := make(map[string]string)
+		if originList, ok := pool["origins"].([]interface{}); ok {
+			for _, o := range originList {
+				origin := o.(map[string]interface{})
+				address, _ := origin["address"].(string)
+				originName, _ := origin["name"].(string)
+				origins[address] = originName
+			}
+		}
+		pools[id] = lbPool{name: name, origins: origins}
+	}
+
+	poolHealth := make(map[string]map[string]interface{})
+	var poolSamples []lbPoolHealth
+	var originSamples []lbOriginHealth
+
+	for _, l := range loadBalancers {
+		lb := l.(map[string]interface{})
+		lbName, _ := lb["name"].(string)
+
+		for _, poolID := range lbPoolIDs(lb) {
+			pool, ok := pools[poolID]
+			if !ok {
+				continue
+			}
+
+			popHealth, fetched := poolHealth[poolID]
+			if !fetched {
+				health, err := c.client.Get(fmt.Sprintf("/accounts/%s/load_balancers/pools/%s/health", c.cfg.AccountID, poolID))
+				if err != nil {
+					log.Printf("  Pool %s health: %v", pool.name, err)
+					continue
+				}
+				healthMap, _ := health.(map[string]interface{})
+				popHealth, _ = healthMap["pop_health"].(map[string]interface{})
+				poolHealth[poolID] = popHealth
+			}
+
+			for region, r := range popHealth {
+				regionHealth, ok := r.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				healthy, _ := regionHealth["healthy"].(bool)
+				poolSamples = append(poolSamples, lbPoolHealth{lbName: lbName, pool: pool.name, region: region, healthy: healthy})
+
+				origins, _ := regionHealth["origins"].([]interface{})
+				for _, o := range origins {
+					originEntry, ok := o.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					for address, s := range originEntry {
+						status, ok := s.(map[string]interface{})
+						if !ok {
+							continue
+						}
+						originName := pool.origins[address]
+						if originName == "" {
+							originName = address
+						}
+
+						sample := lbOriginHealth{lbName: lbName, pool: pool.name, origin: originName, region: region}
+						sample.healthy, _ = status["healthy"].(bool)
+						if rtt, ok := status["rtt"].(string); ok {
+							if d, err := time.ParseDuration(rtt); err == nil {
+								sample.rttMs = float64(d) / float64(time.Millisecond)
+								sample.hasRTT = true
+							}
+						}
+						originSamples = append(originSamples, sample)
+					}
+				}
+			}
+		}
+	}
+
+	c.metrics.LBPoolHealthy.Reset()
+	c.metrics.LBOriginHealthy.Reset()
+	c.metrics.LBOriginRTT.Reset()
+
+	var unhealthyPools int
+	for _, p := range poolSamples {
+		if !p.healthy {
+			unhealthyPools++
+		}
+		c.metrics.LBPoolHealthy.WithLabelValues(c.zoneID, p.lbName, p.pool, p.region).Set(boolToFloat(p.healthy))
+	}
+	for _, o := range originSamples {
+		c.metrics.LBOriginHealthy.WithLabelValues(c.zoneID, o.lbName, o.pool, o.origin, o.region).Set(boolToFloat(o.healthy))
+		if o.hasRTT {
+			c.metrics.LBOriginRTT.WithLabelValues(c.zoneID, o.lbName, o.pool, o.origin, o.region).Set(o.rttMs)
+		}
+	}
+
+	log.Printf(" Load balancers: %d | %d pools | %d unhealthy pool regions",
+		len(loadBalancers), len(pools), unhealthyPools)
+
+	return nil
+}
+
+func (c *Collector) collectLoadBalancerAnalytics() error {
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	query := fmt.Sprintf(`{
+		viewer {
+			zones(filter: {zoneTag: "%s"}) {
+				loadBalancingRequestsAdaptiveGroups(
+					limit: 10000
+					filter: {datetime_geq: "%s", datetime_leq: "%s"}
+				) {
+					count
+					dimensions {
+						lbName
+						selectedPoolName
+						selectedOriginName
+						steeringPolicy
+					}
+				}
+			}
+		}
+	}`, c.zoneID, since.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	result, err := c.client.ExecuteQuery(query)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	zones := result["data"].(map[string]interface{})["viewer"].(map[string]interface{})["zones"].([]interface{})
+	if len(zones) == 0 {
+		return fmt.Errorf("no zones found")
+	}
+
+	zone := zones[0].(map[string]interface{})
+	groups, ok := zone["loadBalancingRequestsAdaptiveGroups"].([]interface{})
+	if !ok {
+		return fmt.Errorf("load balancing analytics not available")
+	}
+
+	c.metrics.LBSteeringRequests.Reset()
+	c.metrics.LBOriginRequests.Reset()
+
+	var totalReqs int64
+	for _, g := range groups {
+		group := g.(map[string]interface{})
+		count := group["count"].(float64)
+		totalReqs += int64(count)
+
+		dims, ok := group["dimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		lbName, _ := dims["lbName"].(string)
+		pool, _ := dims["selectedPoolName"].(string)
+		origin, _ := dims["selectedOriginName"].(string)
+		policy, _ := dims["steeringPolicy"].(string)
+
+		c.metrics.LBSteeringRequests.WithLabelValues(c.zoneID, lbName, pool, policy).Add(count)
+		c.metrics.LBOriginRequests.WithLabelValues(c.zoneID, lbName, pool, origin).Add(count)
+	}
+
+	log.Printf(" Load balancing: %d reqs", totalReqs)
+
+	return nil
+}
+
+// lbPoolIDs returns every pool a load balancer can steer traffic to.
+func lbPoolIDs(lb map[string]interface{}) []string {
+	seen := make(map[string]bool)
+	var ids []string
+
+	add := func(v interface{}) {
+		if id, ok := v.(string); ok && id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	if defaults, ok := lb["default_pools"].([]interface{}); ok {
+		for _, id := range defaults {
+			add(id)
+		}
+	}
+	add(lb["fallback_pool"])
+
+	for _, key := range []string{"region_pools", "pop_pools", "country_pools"} {
+		if m, ok := lb[key].(map[string]interface{}); ok {
+			for _, list := range m {
+				if poolIDs, ok := list.([]interface{}); ok {
+					for _, id := range poolIDs {
+						add(id)
+					}
+				}
+			}
+		}
+	}
+
+	return ids
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,54 @@
+package collector
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestLBPoolIDs(t *testing.T) {
+	tests := []struct {
+		name string
+		lb   map[string]interface{}
+		want []string
+	}{
+		{
+			name: "empty",
+			lb:   map[string]interface{}{},
+			want: nil,
+		},
+		{
+			name: "default and fallback",
+			lb: map[string]interface{}{
+				"default_pools": []interface{}{"p1", "p2"},
+				"fallback_pool": "p3",
+			},
+			want: []string{"p1", "p2", "p3"},
+		},
+		{
+			name: "steering pools are deduplicated",
+			lb: map[string]interface{}{
+				"default_pools": []interface{}{"p1"},
+				"fallback_pool": "p1",
+				"region_pools":  map[string]interface{}{"WNAM": []interface{}{"p2", "p1"}},
+				"pop_pools":     map[string]interface{}{"LAX": []interface{}{"p3"}},
+				"country_pools": map[string]interface{}{"US": []interface{}{"p2", ""}},
+			},
+			want: []string{"p1", "p2", "p3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := lbPoolIDs(tt.lb)
+			sort.Strings(got)
+			if len(got) != len(tt.want) {
+				t.Fatalf("lbPoolIDs() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("lbPoolIDs() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
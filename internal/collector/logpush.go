@@ -0,0 +1,81 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type logpushScope struct {
+	scope string
+	id    string
+}
+
+func (c *Collector) CollectLogpushMetrics() error {
+	scopes := []logpushScope{{"zone", c.zoneID}}
+	if c.cfg.AccountID != "" {
+		scopes = append(scopes, logpushScope{"account", c.cfg.AccountID})
+	}
+
+	jobsByScope := make(map[logpushScope][]interface{})
+	var failedScopes int
+	for _, s := range scopes {
+		result, err := c.client.Get(fmt.Sprintf("/%ss/%s/logpush/jobs", s.scope, s.id))
+		if err != nil {
+			log.Printf("  Logpush %s jobs: %v", s.scope, err)
+			failedScopes++
+			continue
+		}
+		jobsByScope[s], _ = result.([]interface{})
+	}
+	if failedScopes == len(scopes) {
+		return fmt.Errorf("failed to list logpush jobs")
+	}
+
+	var total, failing int
+	for _, s := range scopes {
+		jobs, ok := jobsByScope[s]
+		if !ok {
+			continue
+		}
+
+		scopeLabels := prometheus.Labels{"scope": s.scope, "scope_id": s.id}
+		c.metrics.LogpushJobEnabled.DeletePartialMatch(scopeLabels)
+		c.metrics.LogpushLastComplete.DeletePartialMatch(scopeLabels)
+		c.metrics.LogpushLastError.DeletePartialMatch(scopeLabels)
+		c.metrics.LogpushJobError.DeletePartialMatch(scopeLabels)
+
+		for _, j := range jobs {
+			job := j.(map[string]interface{})
+			id := dimensionString(job["id"])
+			name, _ := job["name"].(string)
+			dataset, _ := job["dataset"].(string)
+			enabled, _ := job["enabled"].(bool)
+			errorMessage, _ := job["error_message"].(string)
+
+			labels := []string{s.scope, s.id, id, name, dataset}
+			c.metrics.LogpushJobEnabled.WithLabelValues(labels...).Set(boolToFloat(enabled))
+
+			lastComplete := parseAPITime(job["last_complete"])
+			lastError := parseAPITime(job["last_error"])
+			if !lastComplete.IsZero() {
+				c.metrics.LogpushLastComplete.WithLabelValues(labels...).Set(float64(lastComplete.Unix()))
+			}
+			if !lastError.IsZero() {
+				c.metrics.LogpushLastError.WithLabelValues(labels...).Set(float64(lastError.Unix()))
+				if lastError.After(lastComplete) {
+					failing++
+				}
+			}
+			if errorMessage != "" {
+				c.metrics.LogpushJobError.WithLabelValues(append(labels, errorMessage)...).Set(1)
+			}
+			total++
+		}
+	}
+
+	log.Printf(" Logpush: %d jobs | %d failing", total, failing)
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+func (c *Collector) CollectMediaMetrics() error {
+	imagesErr := c.collectImages()
+	streamErr := c.collectStream()
+
+	switch {
+	case imagesErr != nil && streamErr != nil:
+		return fmt.Errorf("images: %v; stream: %v", imagesErr, streamErr)
+	case imagesErr != nil:
+		return fmt.Errorf("images: %w", imagesErr)
+	case streamErr != nil:
+		return fmt.Errorf("stream: %w", streamErr)
+	}
+	return nil
+}
+
+func (c *Collector) collectImages() error {
+	accountID := c.cfg.AccountID
+
+	result, err := c.client.Get(fmt.Sprintf("/accounts/%s/images/v1/stats", accountID))
+	if err != nil {
+		return fmt.Errorf("failed to fetch image stats: %w", err)
+	}
+	stats, _ := result.(map[string]interface{})
+	count, _ := stats["count"].(map[string]interface{})
+	current, _ := count["current"].(float64)
+	allowed, _ := count["allowed"].(float64)
+
+	c.metrics.ImagesStored.WithLabelValues(accountID).Set(current)
+	c.metrics.ImagesAllowed.WithLabelValues(accountID).Set(allowed)
+
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	selection := fmt.Sprintf(`imagesRequestsAdaptiveGroups(
+					limit: 10000
+					filter: {datetime_geq: "%s", datetime_leq: "%s"}
+				) {
+					sum {
+						requests
+					}
+				}`, since.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	account, err := c.client.ExecuteAccountQuery(accountID, selection)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	groups, ok := account["imagesRequestsAdaptiveGroups"].([]interface{})
+	if !ok {
+		return fmt.Errorf("images analytics not available")
+	}
+
+	var delivered float64
+	for _, g := range groups {
+		group := g.(map[string]interface{})
+		if sum, ok := group["sum"].(map[string]interface{}); ok {
+			requests, _ := sum["requests"].(float64)
+			delivered += requests
+		}
+	}
+
+	c.metrics.ImagesDelivered.WithLabelValues(accountID).Set(delivered)
+
+	log.Printf(" Images: %.0f of %.0f stored | %.0f delivered", current, allowed, delivered)
+
+	return nil
+}
+
+func (c *Collector) collectStream() error {
+	accountID := c.cfg.AccountID
+
+	result, err := c.client.Get(fmt.Sprintf("/accounts/%s/stream/storage-usage", accountID))
+	if err != nil {
+		return fmt.Errorf("failed to fetch storage usage: %w", err)
+	}
+	usage, _ := result.(map[string]interface{})
+	storedMinutes, _ := usage["totalStorageMinutes"].(float64)
+	minutesLimit, _ := usage["totalStorageMinutesLimit"].(float64)
+	videos, _ := usage["videoCount"].(float64)
+
+	c.metrics.StreamStorageMinutes.WithLabelValues(accountID).Set(storedMinutes)
+	c.metrics.StreamStorageMinutesLimit.WithLabelValues(accountID).Set(minutesLimit)
+	c.metrics.StreamVideos.WithLabelValues(accountID).Set(videos)
+
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	selection := fmt.Sprintf(`streamMinutesViewedAdaptiveGroups(
+					limit: 10000
+					filter: {datetime_geq: "%s", datetime_leq: "%s"}
+				) {
+					count
+					sum {
+						minutesViewed
+					}
+					dimensions {
+						uid
+					}
+				}`, since.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	account, err := c.client.ExecuteAccountQuery(accountID, selection)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	groups, ok := account["streamMinutesViewedAdaptiveGroups"].([]interface{})
+	if !ok {
+		return fmt.Errorf("stream analytics not available")
+	}
+
+	minutesMap := make(map[string]int64)
+	viewsMap := make(map[string]int64)
+	var totalMinutes int64
+
+	for _, g := range groups {
+		group := g.(map[string]interface{})
+		dims, ok := group["dimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		uid, _ := dims["uid"].(string)
+		if uid == "" {
+			continue
+		}
+
+		viewsMap[uid] += int64(group["count"].(float64))
+		if sum, ok := group["sum"].(map[string]interface{}); ok {
+			minutes, _ := sum["minutesViewed"].(float64)
+			minutesMap[uid] += int64(minutes)
+			totalMinutes += int64(minutes)
+		}
+	}
+
+	c.metrics.StreamMinutesViewed.WithLabelValues(accountID).Set(float64(totalMinutes))
+
+	c.metrics.StreamVideoMinutesViewed.Reset()
+	c.metrics.StreamVideoViews.Reset()
+	for uid, minutes := range getTopN(minutesMap, c.cfg.StreamVideoTopN) {
+		name := c.streamVideoName(uid)
+		c.metrics.StreamVideoMinutesViewed.WithLabelValues(accountID, uid, name).Set(float64(minutes))
+		c.metrics.StreamVideoViews.WithLabelValues(accountID, uid, name).Set(float64(viewsMap[uid]))
+	}
+
+	log.Printf(" Stream: %.0f videos | %.0f minutes stored | %d minutes viewed",
+		videos, storedMinutes, totalMinutes)
+
+	return nil
+}
+
+// streamVideoName returns the name set in a video's metadata, falling back
+// to its UID. Names are cached so each video is only looked up once.
+func (c *Collector) streamVideoName(uid string) string {
+	if name, ok := c.streamVideoNames[uid]; ok {
+		return name
+	}
+
+	result, err := c.client.Get(fmt.Sprintf("/accounts/%s/stream/%s", c.cfg.AccountID, uid))
+	if err != nil {
+		// Not cached, so the lookup is retried on the next cycle
+		return uid
+	}
+
+	name := uid
+	video, _ := result.(map[string]interface{})
+	meta, _ := video["meta"].(map[string]interface{})
+	if n, ok := meta["name"].(string); ok && n != "" {
+		name = n
+	}
+	c.streamVideoNames[uid] = name
+	return name
+}
@@ -0,0 +1,128 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+)
+
+// Cloudflare-specific 52x errors are returned by the edge when the origin
+// cannot be reached or returns an invalid response.
+const (
+	cloudflareErrorMin = 520
+	cloudflareErrorMax = 527
+)
+
+func (c *Collector) CollectOriginMetrics() error {
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	query := fmt.Sprintf(`{
+		viewer {
+			zones(filter: {zoneTag: "%s"}) {
+				httpRequestsAdaptiveGroups(
+					limit: 10000
+					filter: {datetime_geq: "%s", datetime_leq: "%s"}
+				) {
+					count
+					dimensions {
+						edgeResponseStatus
+						originResponseStatus
+						clientRequestHTTPHost
+					}
+				}
+			}
+		}
+	}`, c.zoneID, since.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	result, err := c.client.ExecuteQuery(query)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return c.processOriginMetrics(result)
+}
+
+func (c *Collector) processOriginMetrics(data map[string]interface{}) error {
+	zones := data["data"].(map[string]interface{})["viewer"].(map[string]interface{})["zones"].([]interface{})
+	if len(zones) == 0 {
+		return fmt.Errorf("no zones found")
+	}
+
+	zone := zones[0].(map[string]interface{})
+	groups, ok := zone["httpRequestsAdaptiveGroups"].([]interface{})
+	if !ok {
+		return fmt.Errorf("origin metrics not available")
+	}
+
+	type hostStatus struct {
+		host   string
+		status string
+	}
+
+	hostMap := make(map[string]int64)
+	originStatusMap := make(map[hostStatus]int64)
+	cfErrorMap := make(map[hostStatus]int64)
+	mismatchMap := make(map[string]int64)
+	var totalCFErrors, totalMismatch int64
+
+	for _, g := range groups {
+		group := g.(map[string]interface{})
+		count := int64(group["count"].(float64))
+
+		dims, ok := group["dimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		host, _ := dims["clientRequestHTTPHost"].(string)
+		if host == "" {
+			continue
+		}
+		edgeStatus, _ := dims["edgeResponseStatus"].(float64)
+		originStatus, _ := dims["originResponseStatus"].(float64)
+
+		hostMap[host] += count
+
+		// An origin status of 0 means the request never reached the origin
+		// (served from cache, blocked at the edge or failed to connect).
+		if originStatus > 0 {
+			originStatusMap[hostStatus{host, strconv.Itoa(int(originStatus))}] += count
+			if edgeStatus != originStatus {
+				mismatchMap[host] += count
+				totalMismatch += count
+			}
+		}
+
+		if edgeStatus >= cloudflareErrorMin && edgeStatus <= cloudflareErrorMax {
+			cfErrorMap[hostStatus{host, strconv.Itoa(int(edgeStatus))}] += count
+			totalCFErrors += count
+		}
+	}
+
+	topHosts := getTopN(hostMap, 20)
+
+	c.metrics.OriginResponseStatus.Reset()
+	c.metrics.OriginCloudflareErrors.Reset()
+	c.metrics.OriginStatusMismatch.Reset()
+
+	for hs, count := range originStatusMap {
+		if _, ok := topHosts[hs.host]; ok {
+			c.metrics.OriginResponseStatus.WithLabelValues(c.zoneID, hs.host, hs.status).Set(float64(count))
+		}
+	}
+	for hs, count := range cfErrorMap {
+		if _, ok := topHosts[hs.host]; ok {
+			c.metrics.OriginCloudflareErrors.WithLabelValues(c.zoneID, hs.host, hs.status).Set(float64(count))
+		}
+	}
+	for host := range topHosts {
+		c.metrics.OriginStatusMismatch.WithLabelValues(c.zoneID, host).Set(float64(mismatchMap[host]))
+	}
+
+	log.Printf(" Origin: %d hosts | 52x:%d | edge/origin mismatch:%d",
+		len(topHosts), totalCFErrors, totalMismatch)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// pageShieldMaliciousScore is the JS integrity score at or below which
+// Cloudflare classifies a script as likely malicious.
+const pageShieldMaliciousScore = 50
+
+func (c *Collector) CollectPageShieldMetrics() error {
+	resources := []struct {
+		name string
+		path string
+	}{
+		{"script", fmt.Sprintf("/zones/%s/page_shield/scripts", c.zoneID)},
+		{"connection", fmt.Sprintf("/zones/%s/page_shield/connections", c.zoneID)},
+	}
+
+	newSince := time.Now().Add(-24 * time.Hour)
+	counts := make(map[string][3]int)
+
+	for _, r := range resources {
+		items, err := c.client.GetAll(r.path)
+		if err != nil {
+			return fmt.Errorf("failed to list page shield %ss: %w", r.name, err)
+		}
+
+		var newCount, maliciousCount int
+		for _, i := range items {
+			item := i.(map[string]interface{})
+
+			if firstSeen := parseAPITime(item["first_seen_at"]); !firstSeen.IsZero() && firstSeen.After(newSince) {
+				newCount++
+			}
+			if pageShieldMalicious(item) {
+				maliciousCount++
+			}
+		}
+		counts[r.name] = [3]int{len(items), newCount, maliciousCount}
+	}
+
+	c.metrics.PageShieldResources.Reset()
+	c.metrics.PageShieldNewResources.Reset()
+	c.metrics.PageShieldMaliciousResources.Reset()
+
+	for _, r := range resources {
+		count := counts[r.name]
+		c.metrics.PageShieldResources.WithLabelValues(c.zoneID, r.name).Set(float64(count[0]))
+		c.metrics.PageShieldNewResources.WithLabelValues(c.zoneID, r.name).Set(float64(count[1]))
+		c.metrics.PageShieldMaliciousResources.WithLabelValues(c.zoneID, r.name).Set(float64(count[2]))
+	}
+
+	log.Printf(" Page Shield: %d scripts (%d new, %d malicious) | %d connections (%d malicious)",
+		counts["script"][0], counts["script"][1], counts["script"][2],
+		counts["connection"][0], counts["connection"][2])
+
+	return nil
+}
+
+// pageShieldMalicious reports whether a script or connection was flagged
+// through its domain or URL categories or a low JS integrity score.
+func pageShieldMalicious(item map[string]interface{}) bool {
+	for _, key := range []string{"malicious_domain_categories", "malicious_url_categories"} {
+		if categories, ok := item[key].([]interface{}); ok && len(categories) > 0 {
+			return true
+		}
+	}
+
+	if score, ok := item["js_integrity_score"].(float64); ok && score > 0 && score <= pageShieldMaliciousScore {
+		return true
+	}
+
+	return false
+}
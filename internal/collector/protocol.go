@@ -0,0 +1,154 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// trafficBreakdown describes a single httpRequestsAdaptiveGroups dimension
+// exported as requests, bytes and optionally a percentage of total requests.
+type trafficBreakdown struct {
+	name      string
+	dimension string
+	requests  *prometheus.GaugeVec
+	bytes     *prometheus.GaugeVec
+	rate      *prometheus.GaugeVec
+}
+
+func (c *Collector) CollectProtocolMetrics() error {
+	breakdowns := []trafficBreakdown{
+		{"HTTP protocol", "clientRequestHTTPProtocol", c.metrics.HTTPProtocolRequests, c.metrics.HTTPProtocolBytes, c.metrics.HTTPProtocolRate},
+		{"TLS version", "clientSSLProtocol", c.metrics.TLSVersionRequests, c.metrics.TLSVersionBytes, c.metrics.TLSVersionRate},
+		{"IP version", "clientIPVersion", c.metrics.IPVersionRequests, c.metrics.IPVersionBytes, c.metrics.IPVersionRate},
+	}
+
+	return c.collectTrafficBreakdowns(breakdowns)
+}
+
+func (c *Collector) collectTrafficBreakdowns(breakdowns []trafficBreakdown) error {
+	var errs []string
+	for _, b := range breakdowns {
+		if err := c.collectTrafficBreakdown(b); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", b.name, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (c *Collector) collectTrafficBreakdown(b trafficBreakdown) error {
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	query := fmt.Sprintf(`{
+		viewer {
+			zones(filter: {zoneTag: "%s"}) {
+				httpRequestsAdaptiveGroups(
+					limit: 1000
+					filter: {datetime_geq: "%s", datetime_leq: "%s"}
+				) {
+					count
+					sum {
+						edgeResponseBytes
+					}
+					dimensions {
+						%s
+					}
+				}
+			}
+		}
+	}`, c.zoneID, since.Format(time.RFC3339), now.Format(time.RFC3339), b.dimension)
+
+	result, err := c.client.ExecuteQuery(query)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return c.processTrafficBreakdown(result, b)
+}
+
+func (c *Collector) processTrafficBreakdown(data map[string]interface{}, b trafficBreakdown) error {
+	zones := data["data"].(map[string]interface{})["viewer"].(map[string]interface{})["zones"].([]interface{})
+	if len(zones) == 0 {
+		return fmt.Errorf("no zones found")
+	}
+
+	zone := zones[0].(map[string]interface{})
+	groups, ok := zone["httpRequestsAdaptiveGroups"].([]interface{})
+	if !ok {
+		return fmt.Errorf("%s metrics not available", b.name)
+	}
+
+	reqMap := make(map[string]int64)
+	bwMap := make(map[string]int64)
+	var totalReqs int64
+
+	for _, g := range groups {
+		group := g.(map[string]interface{})
+		count := int64(group["count"].(float64))
+		totalReqs += count
+
+		dims, ok := group["dimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		value := dimensionString(dims[b.dimension])
+		if value == "" {
+			value = "unknown"
+		}
+
+		reqMap[value] += count
+		if sum, ok := group["sum"].(map[string]interface{}); ok {
+			if bw, ok := sum["edgeResponseBytes"].(float64); ok {
+				bwMap[value] += int64(bw)
+			}
+		}
+	}
+
+	b.requests.Reset()
+	b.bytes.Reset()
+	if b.rate != nil {
+		b.rate.Reset()
+	}
+
+	for value, reqs := range reqMap {
+		b.requests.WithLabelValues(c.zoneID, value).Set(float64(reqs))
+		b.bytes.WithLabelValues(c.zoneID, value).Set(float64(bwMap[value]))
+
+		if b.rate != nil {
+			rate := float64(0)
+			if totalReqs > 0 {
+				rate = float64(reqs) / float64(totalReqs) * 100
+			}
+			b.rate.WithLabelValues(c.zoneID, value).Set(rate)
+		}
+	}
+
+	log.Printf(" %s: %d values | %d reqs", b.name, len(reqMap), totalReqs)
+
+	return nil
+}
+
+// dimensionString converts a GraphQL dimension value to a label value.
+// Numeric dimensions are decoded as float64 by encoding/json.
+func dimensionString(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case float64:
+		return strconv.FormatInt(int64(val), 10)
+	case bool:
+		return strconv.FormatBool(val)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,146 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// r2OperationClasses maps R2 action types to their billing class. Actions
+// not listed here (deletes, aborted uploads) are free of charge.
+var r2OperationClasses = map[string]string{
+	"ListBuckets":                     "A",
+	"PutBucket":                       "A",
+	"ListObjects":                     "A",
+	"PutObject":                       "A",
+	"CopyObject":                      "A",
+	"CompleteMultipartUpload":         "A",
+	"CreateMultipartUpload":           "A",
+	"ListMultipartUploads":            "A",
+	"UploadPart":                      "A",
+	"UploadPartCopy":                  "A",
+	"ListParts":                       "A",
+	"PutBucketEncryption":             "A",
+	"PutBucketCors":                   "A",
+	"PutBucketLifecycleConfiguration": "A",
+	"HeadBucket":                      "B",
+	"HeadObject":                      "B",
+	"GetObject":                       "B",
+	"UsageSummary":                    "B",
+	"GetBucketEncryption":             "B",
+	"GetBucketLocation":               "B",
+	"GetBucketCors":                   "B",
+	"GetBucketLifecycleConfiguration": "B",
+}
+
+func r2OperationClass(action string) string {
+	if class, ok := r2OperationClasses[action]; ok {
+		return class
+	}
+	return "free"
+}
+
+func (c *Collector) CollectR2Metrics() error {
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	selection := fmt.Sprintf(`r2StorageAdaptiveGroups(
+					limit: 1000
+					filter: {datetime_geq: "%[1]s", datetime_leq: "%[2]s"}
+				) {
+					max {
+						objectCount
+						payloadSize
+						metadataSize
+					}
+					dimensions {
+						bucketName
+					}
+				}
+				r2OperationsAdaptiveGroups(
+					limit: 10000
+					filter: {datetime_geq: "%[1]s", datetime_leq: "%[2]s"}
+				) {
+					sum {
+						requests
+					}
+					dimensions {
+						bucketName
+						actionType
+						actionStatus
+					}
+				}`, since.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	account, err := c.client.ExecuteAccountQuery(c.cfg.AccountID, selection)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return c.processR2Metrics(account)
+}
+
+func (c *Collector) processR2Metrics(account map[string]interface{}) error {
+	storage, ok := account["r2StorageAdaptiveGroups"].([]interface{})
+	if !ok {
+		return fmt.Errorf("R2 metrics not available")
+	}
+	operations, _ := account["r2OperationsAdaptiveGroups"].([]interface{})
+
+	accountID := c.cfg.AccountID
+	var totalObjects, totalBytes, totalOps int64
+
+	c.metrics.R2Objects.Reset()
+	c.metrics.R2StoredBytes.Reset()
+	c.metrics.R2Operations.Reset()
+
+	for _, g := range storage {
+		group := g.(map[string]interface{})
+
+		dims, ok := group["dimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		bucket, _ := dims["bucketName"].(string)
+		if bucket == "" {
+			continue
+		}
+
+		if max, ok := group["max"].(map[string]interface{}); ok {
+			objects, _ := max["objectCount"].(float64)
+			payload, _ := max["payloadSize"].(float64)
+			metadata, _ := max["metadataSize"].(float64)
+
+			totalObjects += int64(objects)
+			totalBytes += int64(payload + metadata)
+
+			c.metrics.R2Objects.WithLabelValues(accountID, bucket).Set(objects)
+			c.metrics.R2StoredBytes.WithLabelValues(accountID, bucket).Set(payload + metadata)
+		}
+	}
+
+	for _, g := range operations {
+		group := g.(map[string]interface{})
+
+		dims, ok := group["dimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		bucket, _ := dims["bucketName"].(string)
+		action, _ := dims["actionType"].(string)
+		status, _ := dims["actionStatus"].(string)
+		if action == "" {
+			continue
+		}
+
+		if sum, ok := group["sum"].(map[string]interface{}); ok {
+			reqs, _ := sum["requests"].(float64)
+			totalOps += int64(reqs)
+			c.metrics.R2Operations.WithLabelValues(accountID, bucket, r2OperationClass(action), action, status).Add(reqs)
+		}
+	}
+
+	log.Printf(" R2: %d buckets | %d objects | %.0f MB | %d operations",
+		len(storage), totalObjects, float64(totalBytes)/1024/1024, totalOps)
+
+	return nil
+}
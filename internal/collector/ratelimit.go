@@ -0,0 +1,116 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+func (c *Collector) CollectRateLimitMetrics() error {
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	query := fmt.Sprintf(`{
+		viewer {
+			zones(filter: {zoneTag: "%s"}) {
+				firewallEventsAdaptiveGroups(
+					limit: 10000
+					filter: {datetime_geq: "%s", datetime_leq: "%s", source: "ratelimit"}
+				) {
+					count
+					dimensions {
+						ruleId
+						action
+						clientIP
+					}
+				}
+			}
+		}
+	}`, c.zoneID, since.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	result, err := c.client.ExecuteQuery(query)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return c.processRateLimitMetrics(result)
+}
+
+func (c *Collector) processRateLimitMetrics(data map[string]interface{}) error {
+	zones := data["data"].(map[string]interface{})["viewer"].(map[string]interface{})["zones"].([]interface{})
+	if len(zones) == 0 {
+		return fmt.Errorf("no zones found")
+	}
+
+	zone := zones[0].(map[string]interface{})
+	groups, ok := zone["firewallEventsAdaptiveGroups"].([]interface{})
+	if !ok {
+		return fmt.Errorf("rate limiting metrics not available")
+	}
+
+	type ruleValue struct {
+		ruleID string
+		value  string
+	}
+
+	triggerMap := make(map[string]int64)
+	modeMap := make(map[ruleValue]int64)
+	ipMap := make(map[string]map[string]int64)
+
+	for _, g := range groups {
+		group := g.(map[string]interface{})
+		count := int64(group["count"].(float64))
+
+		dims, ok := group["dimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ruleID, _ := dims["ruleId"].(string)
+		if ruleID == "" {
+			continue
+		}
+		action, _ := dims["action"].(string)
+		ip, _ := dims["clientIP"].(string)
+
+		triggerMap[ruleID] += count
+
+		// Rules in simulate mode only log the request
+		mode := "mitigated"
+		if action == "log" {
+			mode = "simulated"
+		}
+		modeMap[ruleValue{ruleID, mode}] += count
+
+		if ip != "" {
+			if ipMap[ruleID] == nil {
+				ipMap[ruleID] = make(map[string]int64)
+			}
+			ipMap[ruleID][ip] += count
+		}
+	}
+
+	ruleNames := make(map[string]string)
+	for ruleID, info := range c.ruleInfos() {
+		ruleNames[ruleID] = info.Description
+	}
+
+	c.metrics.RateLimitTriggers.Reset()
+	c.metrics.RateLimitRequests.Reset()
+	c.metrics.RateLimitTopIPs.Reset()
+
+	for ruleID, count := range triggerMap {
+		c.metrics.RateLimitTriggers.WithLabelValues(c.zoneID, ruleID, ruleNames[ruleID]).Set(float64(count))
+	}
+	for rv, count := range modeMap {
+		c.metrics.RateLimitRequests.WithLabelValues(c.zoneID, rv.ruleID, ruleNames[rv.ruleID], rv.value).Set(float64(count))
+	}
+	for ruleID, ips := range ipMap {
+		for ip, count := range getTopN(ips, 10) {
+			c.metrics.RateLimitTopIPs.WithLabelValues(c.zoneID, ruleID, ruleNames[ruleID], ip).Set(float64(count))
+		}
+	}
+
+	log.Printf(" Rate limiting: %d rules triggered", len(triggerMap))
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+type ruleInfo struct {
+	Description string
+	Ruleset     string
+	Phase       string
+}
+
+// ruleInfos returns the cached rule-ID mapping, refreshing it from the
+// rulesets API once RulesetRefreshInterval has passed. On failure the
+// previous mapping (possibly nil) is kept until the next refresh.
+func (c *Collector) ruleInfos() map[string]ruleInfo {
+	if time.Since(c.rulesFetched) < c.cfg.RulesetRefreshInterval {
+		return c.rules
+	}
+	c.rulesFetched = time.Now()
+
+	rules := make(map[string]ruleInfo)
+	if err := c.fetchRulesets(fmt.Sprintf("/zones/%s/rulesets", c.zoneID), rules); err != nil {
+		log.Printf("  Zone rulesets: %v", err)
+		return c.rules
+	}
+
+	if c.cfg.AccountID != "" {
+		if err := c.fetchRulesets(fmt.Sprintf("/accounts/%s/rulesets", c.cfg.AccountID), rules); err != nil {
+			log.Printf("  Account rulesets: %v", err)
+		}
+	}
+
+	c.rules = rules
+	log.Printf(" Rulesets: %d rules cached", len(rules))
+
+	return c.rules
+}
+
+func (c *Collector) fetchRulesets(basePath string, rules map[string]ruleInfo) error {
+	result, err := c.client.Get(basePath)
+	if err != nil {
+		return fmt.Errorf("failed to list rulesets: %w", err)
+	}
+
+	rulesets, _ := result.([]interface{})
+	for _, rs := range rulesets {
+		ruleset := rs.(map[string]interface{})
+		id, _ := ruleset["id"].(string)
+		if id == "" {
+			continue
+		}
+
+		detail, err := c.client.Get(fmt.Sprintf("%s/%s", basePath, id))
+		if err != nil {
+			log.Printf("  Ruleset %s: %v", id, err)
+			continue
+		}
+		detailMap, _ := detail.(map[string]interface{})
+		name, _ := detailMap["name"].(string)
+		phase, _ := detailMap["phase"].(string)
+
+		ruleList, _ := detailMap["rules"].([]interface{})
+		for _, r := range ruleList {
+			rule := r.(map[string]interface{})
+			ruleID, _ := rule["id"].(string)
+			description, _ := rule["description"].(string)
+			if ruleID != "" {
+				rules[ruleID] = ruleInfo{Description: description, Ruleset: name, Phase: phase}
+			}
+		}
+	}
+
+	return nil
+}
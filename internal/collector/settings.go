@@ -0,0 +1,114 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+)
+
+// trackedZoneSettings are exported even without a baseline entry.
+var trackedZoneSettings = map[string]bool{
+	"ssl":                      true,
+	"min_tls_version":          true,
+	"tls_1_3":                  true,
+	"always_use_https":         true,
+	"automatic_https_rewrites": true,
+	"security_header":          true,
+	"security_level":           true,
+	"browser_check":            true,
+	"cache_level":              true,
+	"browser_cache_ttl":        true,
+	"development_mode":         true,
+	"http3":                    true,
+	"0rtt":                     true,
+	"opportunistic_encryption": true,
+	"waf":                      true,
+	"ipv6":                     true,
+	"websockets":               true,
+}
+
+func (c *Collector) CollectZoneSettingMetrics() error {
+	result, err := c.client.Get(fmt.Sprintf("/zones/%s/settings", c.zoneID))
+	if err != nil {
+		return fmt.Errorf("failed to fetch zone settings: %w", err)
+	}
+
+	list, ok := result.([]interface{})
+	if !ok {
+		return fmt.Errorf("zone settings not available")
+	}
+
+	values := make(map[string]string)
+	for _, item := range list {
+		setting := item.(map[string]interface{})
+		id, _ := setting["id"].(string)
+		if !c.zoneSettingTracked(id) {
+			continue
+		}
+		flattenSetting(id, setting["value"], values)
+	}
+
+	c.metrics.ZoneSettingInfo.Reset()
+	c.metrics.ZoneSettingEnabled.Reset()
+	c.metrics.ZoneSettingDrift.Reset()
+
+	for name, value := range values {
+		c.metrics.ZoneSettingInfo.WithLabelValues(c.zoneID, name, value).Set(1)
+
+		switch value {
+		case "on", "true":
+			c.metrics.ZoneSettingEnabled.WithLabelValues(c.zoneID, name).Set(1)
+		case "off", "false":
+			c.metrics.ZoneSettingEnabled.WithLabelValues(c.zoneID, name).Set(0)
+		}
+	}
+
+	var drifted []string
+	for name, expected := range c.cfg.ZoneSettingsBaseline {
+		actual, ok := values[name]
+		drift := float64(0)
+		if !ok || actual != expected {
+			drift = 1
+			drifted = append(drifted, name)
+		}
+		c.metrics.ZoneSettingDrift.WithLabelValues(c.zoneID, name, expected).Set(drift)
+	}
+	sort.Strings(drifted)
+
+	if len(drifted) > 0 {
+		log.Printf(" Zone settings: %d tracked | drift: %s", len(values), strings.Join(drifted, ", "))
+	} else {
+		log.Printf(" Zone settings: %d tracked | no drift", len(values))
+	}
+
+	return nil
+}
+
+func (c *Collector) zoneSettingTracked(id string) bool {
+	if trackedZoneSettings[id] {
+		return true
+	}
+	for name := range c.cfg.ZoneSettingsBaseline {
+		if name == id || strings.HasPrefix(name, id+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenSetting stores scalar setting values under their name and nested
+// objects under dot-separated names, e.g.
+// security_header.strict_transport_security.max_age.
+func flattenSetting(name string, value interface{}, out map[string]string) {
+	if nested, ok := value.(map[string]interface{}); ok {
+		for k, v := range nested {
+			flattenSetting(name+"."+k, v, out)
+		}
+		return
+	}
+
+	if s := dimensionString(value); s != "" {
+		out[name] = s
+	}
+}
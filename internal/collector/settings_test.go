@@ -0,0 +1,60 @@
+package collector
+
+import "testing"
+
+func TestFlattenSetting(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  map[string]string
+	}{
+		{
+			name:  "string",
+			value: "strict",
+			want:  map[string]string{"ssl": "strict"},
+		},
+		{
+			name:  "number",
+			value: 14400.0,
+			want:  map[string]string{"ssl": "14400"},
+		},
+		{
+			name:  "bool",
+			value: true,
+			want:  map[string]string{"ssl": "true"},
+		},
+		{
+			name: "nested",
+			value: map[string]interface{}{
+				"strict_transport_security": map[string]interface{}{
+					"enabled": true,
+					"max_age": 31536000.0,
+				},
+			},
+			want: map[string]string{
+				"ssl.strict_transport_security.enabled": "true",
+				"ssl.strict_transport_security.max_age": "31536000",
+			},
+		},
+		{
+			name:  "unsupported values are dropped",
+			value: []interface{}{"a", "b"},
+			want:  map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make(map[string]string)
+			flattenSetting("ssl", tt.value, got)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("got[%q] = %q, want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+}
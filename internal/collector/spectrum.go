@@ -0,0 +1,215 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// magicTransitWindow is the period Magic Transit rates are averaged over.
+const magicTransitWindow = 5 * time.Minute
+
+type spectrumApp struct {
+	name     string
+	protocol string
+}
+
+func (c *Collector) CollectSpectrumMetrics() error {
+	if err := c.collectSpectrumApps(); err != nil {
+		return err
+	}
+
+	if c.cfg.MagicTransitEnabled {
+		if err := c.collectMagicTransit(); err != nil {
+			return fmt.Errorf("magic transit: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Collector) collectSpectrumApps() error {
+	appList, err := c.client.GetAll(fmt.Sprintf("/zones/%s/spectrum/apps", c.zoneID))
+	if err != nil {
+		return fmt.Errorf("failed to list spectrum applications: %w", err)
+	}
+	if len(appList) == 0 {
+		c.metrics.SpectrumConnections.Reset()
+		c.metrics.SpectrumBytesIngress.Reset()
+		c.metrics.SpectrumBytesEgress.Reset()
+		c.metrics.SpectrumDurationAvg.Reset()
+		return nil
+	}
+
+	apps := make(map[string]spectrumApp)
+	for _, a := range appList {
+		app := a.(map[string]interface{})
+		id, _ := app["id"].(string)
+		protocol, _ := app["protocol"].(string)
+		name := id
+		if dns, ok := app["dns"].(map[string]interface{}); ok {
+			if n, ok := dns["name"].(string); ok && n != "" {
+				name = n
+			}
+		}
+		apps[id] = spectrumApp{name: name, protocol: protocol}
+	}
+
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	query := fmt.Sprintf(`{
+		viewer {
+			zones(filter: {zoneTag: "%s"}) {
+				spectrumNetworkAnalyticsAdaptiveGroups(
+					limit: 10000
+					filter: {datetime_geq: "%s", datetime_leq: "%s"}
+				) {
+					count
+					sum {
+						bytesIngress
+						bytesEgress
+					}
+					avg {
+						durationMs
+					}
+					dimensions {
+						appId
+						protocol
+					}
+				}
+			}
+		}
+	}`, c.zoneID, since.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	result, err := c.client.ExecuteQuery(query)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	zones := result["data"].(map[string]interface{})["viewer"].(map[string]interface{})["zones"].([]interface{})
+	if len(zones) == 0 {
+		return fmt.Errorf("no zones found")
+	}
+
+	zone := zones[0].(map[string]interface{})
+	groups, ok := zone["spectrumNetworkAnalyticsAdaptiveGroups"].([]interface{})
+	if !ok {
+		return fmt.Errorf("spectrum metrics not available")
+	}
+
+	type appProtocol struct {
+		appID    string
+		protocol string
+	}
+
+	connMap := make(map[appProtocol]float64)
+	ingressMap := make(map[appProtocol]float64)
+	egressMap := make(map[appProtocol]float64)
+	durationMap := make(map[appProtocol]float64)
+
+	var totalConnections float64
+	for _, g := range groups {
+		group := g.(map[string]interface{})
+		count := group["count"].(float64)
+
+		dims, ok := group["dimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key := appProtocol{appID: dimensionString(dims["appId"]), protocol: dimensionString(dims["protocol"])}
+		if key.protocol == "" {
+			key.protocol = apps[key.appID].protocol
+		}
+
+		connMap[key] += count
+		totalConnections += count
+		if sum, ok := group["sum"].(map[string]interface{}); ok {
+			ingress, _ := sum["bytesIngress"].(float64)
+			egress, _ := sum["bytesEgress"].(float64)
+			ingressMap[key] += ingress
+			egressMap[key] += egress
+		}
+		if avg, ok := group["avg"].(map[string]interface{}); ok {
+			duration, _ := avg["durationMs"].(float64)
+			durationMap[key] += duration * count
+		}
+	}
+
+	c.metrics.SpectrumConnections.Reset()
+	c.metrics.SpectrumBytesIngress.Reset()
+	c.metrics.SpectrumBytesEgress.Reset()
+	c.metrics.SpectrumDurationAvg.Reset()
+
+	for key, connections := range connMap {
+		name := key.appID
+		if app, ok := apps[key.appID]; ok {
+			name = app.name
+		}
+		labels := []string{c.zoneID, key.appID, name, key.protocol}
+
+		avgDuration := float64(0)
+		if connections > 0 {
+			avgDuration = durationMap[key] / connections
+		}
+
+		c.metrics.SpectrumConnections.WithLabelValues(labels...).Set(connections)
+		c.metrics.SpectrumBytesIngress.WithLabelValues(labels...).Set(ingressMap[key])
+		c.metrics.SpectrumBytesEgress.WithLabelValues(labels...).Set(egressMap[key])
+		c.metrics.SpectrumDurationAvg.WithLabelValues(labels...).Set(avgDuration)
+	}
+
+	log.Printf(" Spectrum: %d applications | %.0f connections", len(apps), totalConnections)
+
+	return nil
+}
+
+func (c *Collector) collectMagicTransit() error {
+	now := time.Now()
+	since := now.Add(-magicTransitWindow)
+
+	selection := fmt.Sprintf(`magicTransitNetworkAnalyticsAdaptiveGroups(
+					limit: 100
+					filter: {datetime_geq: "%s", datetime_leq: "%s"}
+				) {
+					sum {
+						bits
+						packets
+					}
+					dimensions {
+						outcome
+					}
+				}`, since.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	account, err := c.client.ExecuteAccountQuery(c.cfg.AccountID, selection)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	groups, ok := account["magicTransitNetworkAnalyticsAdaptiveGroups"].([]interface{})
+	if !ok {
+		return fmt.Errorf("magic transit metrics not available")
+	}
+
+	c.metrics.MagicTransitBitRate.Reset()
+	c.metrics.MagicTransitPacketRate.Reset()
+
+	seconds := magicTransitWindow.Seconds()
+	for _, g := range groups {
+		group := g.(map[string]interface{})
+		dims, ok := group["dimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		action, _ := dims["outcome"].(string)
+
+		if sum, ok := group["sum"].(map[string]interface{}); ok {
+			bits, _ := sum["bits"].(float64)
+			packets, _ := sum["packets"].(float64)
+			c.metrics.MagicTransitBitRate.WithLabelValues(c.cfg.AccountID, action).Add(bits / seconds)
+			c.metrics.MagicTransitPacketRate.WithLabelValues(c.cfg.AccountID, action).Add(packets / seconds)
+		}
+	}
+
+	return nil
+}
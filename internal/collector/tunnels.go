@@ -0,0 +1,131 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+)
+
+// tunnelStatuses are the states reported by the Cloudflare Tunnel API.
+var tunnelStatuses = []string{"healthy", "degraded", "down", "inactive"}
+
+type tunnel struct {
+	id          string
+	name        string
+	status      string
+	activeAt    time.Time
+	inactiveAt  time.Time
+	connections map[string]int // colo -> active connections
+	connectors  []tunnelConnector
+}
+
+type tunnelConnector struct {
+	id      string
+	version string
+	arch    string
+}
+
+func (c *Collector) CollectTunnelMetrics() error {
+	tunnelList, err := c.client.GetAll(fmt.Sprintf("/accounts/%s/cfd_tunnel?is_deleted=false", c.cfg.AccountID))
+	if err != nil {
+		return fmt.Errorf("failed to list tunnels: %w", err)
+	}
+
+	tunnels := make([]tunnel, 0, len(tunnelList))
+	for _, t := range tunnelList {
+		item := t.(map[string]interface{})
+		tun := tunnel{
+			connections: make(map[string]int),
+			activeAt:    parseAPITime(item["conns_active_at"]),
+			inactiveAt:  parseAPITime(item["conns_inactive_at"]),
+		}
+		tun.id, _ = item["id"].(string)
+		tun.name, _ = item["name"].(string)
+		tun.status, _ = item["status"].(string)
+
+		if conns, ok := item["connections"].([]interface{}); ok {
+			for _, cn := range conns {
+				conn := cn.(map[string]interface{})
+				if pending, _ := conn["is_pending_reconnect"].(bool); pending {
+					continue
+				}
+				colo, _ := conn["colo_name"].(string)
+				tun.connections[colo]++
+			}
+		}
+
+		connectors, err := c.fetchTunnelConnectors(tun.id)
+		if err != nil {
+			log.Printf("  Tunnel %s connectors: %v", tun.name, err)
+		}
+		tun.connectors = connectors
+
+		tunnels = append(tunnels, tun)
+	}
+
+	accountID := c.cfg.AccountID
+	statusCounts := make(map[string]int)
+
+	c.metrics.TunnelStatus.Reset()
+	c.metrics.TunnelConnections.Reset()
+	c.metrics.TunnelActiveConnections.Reset()
+	c.metrics.TunnelConnectorInfo.Reset()
+	c.metrics.TunnelActiveAt.Reset()
+	c.metrics.TunnelInactiveAt.Reset()
+
+	for _, tun := range tunnels {
+		statusCounts[tun.status]++
+
+		for _, s := range tunnelStatuses {
+			value := float64(0)
+			if s == tun.status {
+				value = 1
+			}
+			c.metrics.TunnelStatus.WithLabelValues(accountID, tun.id, tun.name, s).Set(value)
+		}
+
+		if !tun.activeAt.IsZero() {
+			c.metrics.TunnelActiveAt.WithLabelValues(accountID, tun.id, tun.name).Set(float64(tun.activeAt.Unix()))
+		}
+		if !tun.inactiveAt.IsZero() {
+			c.metrics.TunnelInactiveAt.WithLabelValues(accountID, tun.id, tun.name).Set(float64(tun.inactiveAt.Unix()))
+		}
+
+		var total int
+		for colo, count := range tun.connections {
+			c.metrics.TunnelConnections.WithLabelValues(accountID, tun.id, tun.name, colo).Set(float64(count))
+			total += count
+		}
+		c.metrics.TunnelActiveConnections.WithLabelValues(accountID, tun.id, tun.name).Set(float64(total))
+
+		for _, cn := range tun.connectors {
+			c.metrics.TunnelConnectorInfo.WithLabelValues(accountID, tun.id, tun.name, cn.id, cn.version, cn.arch).Set(1)
+		}
+	}
+
+	log.Printf(" Tunnels: %d | healthy:%d degraded:%d down:%d",
+		len(tunnels), statusCounts["healthy"], statusCounts["degraded"], statusCounts["down"])
+
+	return nil
+}
+
+func (c *Collector) fetchTunnelConnectors(tunnelID string) ([]tunnelConnector, error) {
+	result, err := c.client.Get(fmt.Sprintf("/accounts/%s/cfd_tunnel/%s/connections", c.cfg.AccountID, url.PathEscape(tunnelID)))
+	if err != nil {
+		return nil, err
+	}
+
+	list, _ := result.([]interface{})
+	connectors := make([]tunnelConnector, 0, len(list))
+	for _, cn := range list {
+		connector := cn.(map[string]interface{})
+		var tc tunnelConnector
+		tc.id, _ = connector["id"].(string)
+		tc.version, _ = connector["version"].(string)
+		tc.arch, _ = connector["arch"].(string)
+		connectors = append(connectors, tc)
+	}
+
+	return connectors, nil
+}
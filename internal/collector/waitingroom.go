@@ -0,0 +1,110 @@
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"cloudflare-exporter/pkg/cloudflare"
+)
+
+// waitingRoomStatuses are the states reported by the waiting room status
+// endpoint, plus "suspended" for rooms that are switched off.
+var waitingRoomStatuses = []string{"queueing", "not_queueing", "event_prequeueing", "suspended"}
+
+// CollectWaitingRoomMetrics is polled on WaitingRoomInterval rather than as
+// part of CollectAll, so it runs concurrently with the other collectors and
+// must only touch the client and metrics.
+func (c *Collector) CollectWaitingRoomMetrics() error {
+	if c.waitingRoomsDisabled || time.Now().Before(c.waitingRoomsIdleUntil) {
+		return nil
+	}
+
+	rooms, err := c.client.GetAll(fmt.Sprintf("/zones/%s/waiting_rooms", c.zoneID))
+	if err != nil {
+		// Zones without the Waiting Room entitlement (or tokens without
+		// access) would otherwise log this error every few seconds.
+		var apiErr *cloudflare.APIError
+		if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusForbidden || apiErr.StatusCode == http.StatusUnauthorized) {
+			c.waitingRoomsDisabled = true
+			log.Printf("ℹ️  Waiting rooms not available, disabling collector: %v", err)
+			return nil
+		}
+		return fmt.Errorf("failed to list waiting rooms: %w", err)
+	}
+
+	// Without rooms there is nothing to watch closely; check again at
+	// the regular scrape interval.
+	if len(rooms) == 0 {
+		c.waitingRoomsIdleUntil = time.Now().Add(c.cfg.ScrapeInterval)
+	}
+
+	type roomState struct {
+		name          string
+		activeLimit   float64
+		newUsersLimit float64
+		status        map[string]interface{} // nil when the status fetch failed
+		suspended     bool
+	}
+
+	states := make([]roomState, 0, len(rooms))
+	for _, r := range rooms {
+		room := r.(map[string]interface{})
+		id, _ := room["id"].(string)
+
+		var state roomState
+		state.name, _ = room["name"].(string)
+		state.suspended, _ = room["suspended"].(bool)
+		state.activeLimit, _ = room["total_active_users"].(float64)
+		state.newUsersLimit, _ = room["new_users_per_minute"].(float64)
+
+		result, err := c.client.Get(fmt.Sprintf("/zones/%s/waiting_rooms/%s/status", c.zoneID, id))
+		if err != nil {
+			log.Printf("  Waiting room %s status: %v", state.name, err)
+		} else {
+			state.status, _ = result.(map[string]interface{})
+		}
+
+		states = append(states, state)
+	}
+
+	c.metrics.WaitingRoomStatus.Reset()
+	c.metrics.WaitingRoomActiveUsers.Reset()
+	c.metrics.WaitingRoomQueuedUsers.Reset()
+	c.metrics.WaitingRoomEstimatedWait.Reset()
+	c.metrics.WaitingRoomActiveUsersLimit.Reset()
+	c.metrics.WaitingRoomNewUsersLimit.Reset()
+
+	for _, room := range states {
+		c.metrics.WaitingRoomActiveUsersLimit.WithLabelValues(c.zoneID, room.name).Set(room.activeLimit)
+		c.metrics.WaitingRoomNewUsersLimit.WithLabelValues(c.zoneID, room.name).Set(room.newUsersLimit)
+
+		if room.status == nil {
+			continue
+		}
+
+		current, _ := room.status["status"].(string)
+		if room.suspended {
+			current = "suspended"
+		}
+		for _, s := range waitingRoomStatuses {
+			value := float64(0)
+			if s == current {
+				value = 1
+			}
+			c.metrics.WaitingRoomStatus.WithLabelValues(c.zoneID, room.name, s).Set(value)
+		}
+
+		activeUsers, _ := room.status["estimated_total_active_users"].(float64)
+		queuedUsers, _ := room.status["estimated_queued_users"].(float64)
+		waitMinutes, _ := room.status["max_estimated_time_minutes"].(float64)
+
+		c.metrics.WaitingRoomActiveUsers.WithLabelValues(c.zoneID, room.name).Set(activeUsers)
+		c.metrics.WaitingRoomQueuedUsers.WithLabelValues(c.zoneID, room.name).Set(queuedUsers)
+		c.metrics.WaitingRoomEstimatedWait.WithLabelValues(c.zoneID, room.name).Set(waitMinutes)
+	}
+
+	return nil
+}
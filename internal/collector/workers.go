@@ -0,0 +1,115 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// analyticsQuantiles maps the quantile label to the suffix used by the
+// GraphQL quantile fields (e.g. cpuTimeP50, processingTimeUsP99).
+var analyticsQuantiles = map[string]string{
+	"0.5":  "P50",
+	"0.9":  "P90",
+	"0.99": "P99",
+}
+
+func (c *Collector) CollectWorkersMetrics() error {
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	selection := fmt.Sprintf(`workersInvocationsAdaptive(
+					limit: 10000
+					filter: {datetime_geq: "%s", datetime_leq: "%s"}
+				) {
+					sum {
+						requests
+						errors
+						subrequests
+						wallTime
+					}
+					quantiles {
+						cpuTimeP50
+						cpuTimeP90
+						cpuTimeP99
+						wallTimeP50
+						wallTimeP90
+						wallTimeP99
+					}
+					dimensions {
+						scriptName
+						status
+					}
+				}`, since.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	account, err := c.client.ExecuteAccountQuery(c.cfg.AccountID, selection)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return c.processWorkersMetrics(account)
+}
+
+func (c *Collector) processWorkersMetrics(account map[string]interface{}) error {
+	groups, ok := account["workersInvocationsAdaptive"].([]interface{})
+	if !ok {
+		return fmt.Errorf("workers metrics not available")
+	}
+
+	accountID := c.cfg.AccountID
+	scripts := make(map[string]bool)
+	var totalReqs, totalErrors int64
+
+	c.metrics.WorkerRequests.Reset()
+	c.metrics.WorkerErrors.Reset()
+	c.metrics.WorkerSubrequests.Reset()
+	c.metrics.WorkerWallTime.Reset()
+	c.metrics.WorkerCPUTimeQuantile.Reset()
+	c.metrics.WorkerWallTimeQuantile.Reset()
+
+	for _, g := range groups {
+		group := g.(map[string]interface{})
+
+		dims, ok := group["dimensions"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		script, _ := dims["scriptName"].(string)
+		status, _ := dims["status"].(string)
+		if script == "" {
+			continue
+		}
+		scripts[script] = true
+
+		if sum, ok := group["sum"].(map[string]interface{}); ok {
+			reqs, _ := sum["requests"].(float64)
+			errs, _ := sum["errors"].(float64)
+			subreqs, _ := sum["subrequests"].(float64)
+			wallTime, _ := sum["wallTime"].(float64)
+
+			totalReqs += int64(reqs)
+			totalErrors += int64(errs)
+
+			c.metrics.WorkerRequests.WithLabelValues(accountID, script, status).Add(reqs)
+			c.metrics.WorkerErrors.WithLabelValues(accountID, script, status).Add(errs)
+			c.metrics.WorkerSubrequests.WithLabelValues(accountID, script, status).Add(subreqs)
+			// wallTime is reported in microseconds
+			c.metrics.WorkerWallTime.WithLabelValues(accountID, script, status).Add(wallTime / 1000)
+		}
+
+		if quantiles, ok := group["quantiles"].(map[string]interface{}); ok {
+			for quantile, suffix := range analyticsQuantiles {
+				if v, ok := quantiles["cpuTime"+suffix].(float64); ok {
+					c.metrics.WorkerCPUTimeQuantile.WithLabelValues(accountID, script, status, quantile).Set(v / 1000)
+				}
+				if v, ok := quantiles["wallTime"+suffix].(float64); ok {
+					c.metrics.WorkerWallTimeQuantile.WithLabelValues(accountID, script, status, quantile).Set(v / 1000)
+				}
+			}
+		}
+	}
+
+	log.Printf(" Workers: %d scripts | %d reqs | %d errors", len(scripts), totalReqs, totalErrors)
+
+	return nil
+}
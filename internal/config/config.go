@@ -1,8 +1,12 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/netip"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -11,8 +15,64 @@ type Config struct {
 	ZoneID         string
 	Port           string
 	ScrapeInterval time.Duration
+
+	// AccountID enables account-level collectors (Workers, R2, ...)
+	AccountID string
+
+	// ColoMapping holds the entries of COLO_MAPPING_FILE, which add to or
+	// override the built-in colo location mapping
+	ColoMapping map[string]ColoLocation
+
+	// ASNTopN limits the number of ASNs exported before the rest are
+	// folded into an "other" series; ASNAlwaysExport bypasses that limit
+	ASNTopN         int
+	ASNAlwaysExport []string
+
+	// DNSQueryNameTopN limits the number of query names exported
+	DNSQueryNameTopN int
+
+	// RulesetRefreshInterval controls how often rule names are re-fetched
+	RulesetRefreshInterval time.Duration
+
+	// FirewallDimensions is the label set of the multi-dimensional firewall
+	// metric; FirewallSeriesLimit caps the number of series it exports
+	FirewallDimensions  []string
+	FirewallSeriesLimit int
+
+	// ZoneSettingsBaseline maps a zone setting (nested values joined with
+	// dots, e.g. security_header.strict_transport_security.enabled) to
+	// its expected value
+	ZoneSettingsBaseline map[string]string
+
+	// DNSRecordInfo enables the per-record info metric; records pointing
+	// into DNSDecommissionedRanges are reported as orphaned
+	DNSRecordInfo           bool
+	DNSDecommissionedRanges []netip.Prefix
+
+	// GatewayDomainLimit caps the number of Gateway domain series
+	GatewayDomainLimit int
+
+	// WaitingRoomInterval is how often waiting rooms are polled,
+	// independently of ScrapeInterval
+	WaitingRoomInterval time.Duration
+
+	// MagicTransitEnabled adds Magic Transit rates to the Spectrum collector
+	MagicTransitEnabled bool
+
+	// StreamVideoTopN limits the number of Stream videos exported
+	StreamVideoTopN int
 }
 
+// ColoLocation is the location of a Cloudflare data center
+type ColoLocation struct {
+	City      string `json:"city"`
+	Region    string `json:"region"`
+	Continent string `json:"continent"`
+}
+
+// FirewallDimensionNames lists the labels accepted in FIREWALL_DIMENSIONS
+var FirewallDimensionNames = []string{"action", "source", "rule_id", "host", "country", "method", "path"}
+
 func LoadFromEnv() (*Config, error) {
 	apiToken := os.Getenv("CLOUDFLARE_API_TOKEN")
 	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
@@ -25,17 +85,241 @@ func LoadFromEnv() (*Config, error) {
 		return nil, fmt.Errorf("CLOUDFLARE_ZONE_ID environment variable is required")
 	}
 
+	coloMapping, err := loadColoMapping(os.Getenv("COLO_MAPPING_FILE"))
+	if err != nil {
+		return nil, err
+	}
+
+	asnTopN, err := getEnvPositiveInt("ASN_TOP_N", 50)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsQueryNameTopN, err := getEnvPositiveInt("DNS_QUERY_NAME_TOP_N", 20)
+	if err != nil {
+		return nil, err
+	}
+
+	rulesetRefreshInterval, err := getEnvDuration("RULESET_REFRESH_INTERVAL", time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	firewallDimensions := getEnvList("FIREWALL_DIMENSIONS")
+	if len(firewallDimensions) == 0 {
+		firewallDimensions = []string{"action", "source", "country"}
+	}
+	if err := validateFirewallDimensions(firewallDimensions); err != nil {
+		return nil, err
+	}
+
+	firewallSeriesLimit, err := getEnvPositiveInt("FIREWALL_SERIES_LIMIT", 500)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneSettingsBaseline, err := getEnvMap("ZONE_SETTINGS_BASELINE")
+	if err != nil {
+		return nil, err
+	}
+
+	dnsRecordInfo, err := getEnvBool("DNS_RECORD_INFO", false)
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayDomainLimit, err := getEnvPositiveInt("GATEWAY_DOMAIN_LIMIT", 50)
+	if err != nil {
+		return nil, err
+	}
+
+	waitingRoomInterval, err := getEnvDuration("WAITING_ROOM_INTERVAL", 10*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	if waitingRoomInterval <= 0 {
+		return nil, fmt.Errorf("WAITING_ROOM_INTERVAL must be positive")
+	}
+
+	magicTransitEnabled, err := getEnvBool("MAGIC_TRANSIT_ENABLED", false)
+	if err != nil {
+		return nil, err
+	}
+	if magicTransitEnabled && os.Getenv("CLOUDFLARE_ACCOUNT_ID") == "" {
+		return nil, fmt.Errorf("MAGIC_TRANSIT_ENABLED requires CLOUDFLARE_ACCOUNT_ID")
+	}
+
+	streamVideoTopN, err := getEnvPositiveInt("STREAM_VIDEO_TOP_N", 20)
+	if err != nil {
+		return nil, err
+	}
+
+	var dnsDecommissionedRanges []netip.Prefix
+	for _, r := range getEnvList("DNS_DECOMMISSIONED_RANGES") {
+		prefix, err := netip.ParsePrefix(r)
+		if err != nil {
+			return nil, fmt.Errorf("DNS_DECOMMISSIONED_RANGES: %w", err)
+		}
+		dnsDecommissionedRanges = append(dnsDecommissionedRanges, prefix)
+	}
+
 	return &Config{
 		APIToken:       apiToken,
 		ZoneID:         zoneID,
 		Port:           getEnvOrDefault("EXPORTER_PORT", "9199"),
 		ScrapeInterval: 60 * time.Second,
+
+		AccountID: os.Getenv("CLOUDFLARE_ACCOUNT_ID"),
+
+		ColoMapping: coloMapping,
+
+		ASNTopN:         asnTopN,
+		ASNAlwaysExport: getEnvList("ASN_ALWAYS_EXPORT"),
+
+		DNSQueryNameTopN: dnsQueryNameTopN,
+
+		RulesetRefreshInterval: rulesetRefreshInterval,
+
+		FirewallDimensions:  firewallDimensions,
+		FirewallSeriesLimit: firewallSeriesLimit,
+
+		ZoneSettingsBaseline: zoneSettingsBaseline,
+
+		DNSRecordInfo:           dnsRecordInfo,
+		DNSDecommissionedRanges: dnsDecommissionedRanges,
+
+		GatewayDomainLimit: gatewayDomainLimit,
+
+		WaitingRoomInterval: waitingRoomInterval,
+
+		MagicTransitEnabled: magicTransitEnabled,
+
+		StreamVideoTopN: streamVideoTopN,
 	}, nil
 }
 
+// loadColoMapping parses the JSON colo mapping file at path, if any.
+func loadColoMapping(path string) (map[string]ColoLocation, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("COLO_MAPPING_FILE: %w", err)
+	}
+
+	var mapping map[string]ColoLocation
+	if err := json.Unmarshal(data, &mapping); err != nil {
+		return nil, fmt.Errorf("COLO_MAPPING_FILE: failed to parse %s: %w", path, err)
+	}
+	return mapping, nil
+}
+
+func validateFirewallDimensions(dimensions []string) error {
+	seen := make(map[string]bool)
+	for _, d := range dimensions {
+		if seen[d] {
+			return fmt.Errorf("FIREWALL_DIMENSIONS contains %q twice", d)
+		}
+		seen[d] = true
+
+		valid := false
+		for _, name := range FirewallDimensionNames {
+			if d == name {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("FIREWALL_DIMENSIONS: unknown dimension %q (valid: %s)",
+				d, strings.Join(FirewallDimensionNames, ", "))
+		}
+	}
+	return nil
+}
+
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+func getEnvInt(key string, defaultValue int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be an integer: %w", key, err)
+	}
+	return n, nil
+}
+
+// getEnvPositiveInt is getEnvInt for limits, where zero or a negative
+// value would silently export nothing.
+func getEnvPositiveInt(key string, defaultValue int) (int, error) {
+	n, err := getEnvInt(key, defaultValue)
+	if err != nil {
+		return 0, err
+	}
+	if n <= 0 {
+		return 0, fmt.Errorf("%s must be positive", key)
+	}
+	return n, nil
+}
+
+func getEnvBool(key string, defaultValue bool) (bool, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%s must be true or false: %w", key, err)
+	}
+	return b, nil
+}
+
+func getEnvDuration(key string, defaultValue time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a duration (e.g. 30m): %w", key, err)
+	}
+	return d, nil
+}
+
+// getEnvList parses a comma-separated environment variable, dropping
+// empty entries.
+func getEnvList(key string) []string {
+	var values []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// getEnvMap parses a comma-separated list of key=value pairs.
+func getEnvMap(key string) (map[string]string, error) {
+	values := make(map[string]string)
+	for _, entry := range getEnvList(key) {
+		k, v, ok := strings.Cut(entry, "=")
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		if !ok || k == "" {
+			return nil, fmt.Errorf("%s: invalid entry %q, expected key=value", key, entry)
+		}
+		values[k] = v
+	}
+	return values, nil
+}
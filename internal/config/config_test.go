@@ -0,0 +1,168 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetEnvMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "unset",
+			value: "",
+			want:  map[string]string{},
+		},
+		{
+			name:  "pairs with whitespace",
+			value: " ssl = strict , min_tls_version=1.2,,",
+			want:  map[string]string{"ssl": "strict", "min_tls_version": "1.2"},
+		},
+		{
+			name:  "empty value",
+			value: "development_mode=",
+			want:  map[string]string{"development_mode": ""},
+		},
+		{
+			name:  "value containing equals",
+			value: "a=b=c",
+			want:  map[string]string{"a": "b=c"},
+		},
+		{
+			name:    "missing equals",
+			value:   "ssl",
+			wantErr: true,
+		},
+		{
+			name:    "missing key",
+			value:   "=strict",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_ENV_MAP", tt.value)
+
+			got, err := getEnvMap("TEST_ENV_MAP")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("getEnvMap() = %v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("getEnvMap(): %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("getEnvMap() = %v, want %v", got, tt.want)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("got[%q] = %q, want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadColoMapping(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	valid := write("valid.json", `{"AMS": {"city": "Amsterdam", "region": "NL", "continent": "EU"}}`)
+	malformed := write("malformed.json", `{"AMS": `)
+
+	tests := []struct {
+		name    string
+		path    string
+		want    map[string]ColoLocation
+		wantErr bool
+	}{
+		{"unset", "", nil, false},
+		{"valid", valid, map[string]ColoLocation{"AMS": {City: "Amsterdam", Region: "NL", Continent: "EU"}}, false},
+		{"missing", filepath.Join(dir, "missing.json"), nil, true},
+		{"malformed", malformed, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := loadColoMapping(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("loadColoMapping() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("loadColoMapping() = %v, want %v", got, tt.want)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("got[%q] = %+v, want %+v", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateFirewallDimensions(t *testing.T) {
+	tests := []struct {
+		name       string
+		dimensions []string
+		wantErr    bool
+	}{
+		{"defaults", []string{"action", "source", "country"}, false},
+		{"all", FirewallDimensionNames, false},
+		{"empty", nil, false},
+		{"unknown", []string{"action", "colo"}, true},
+		{"duplicate", []string{"action", "action"}, true},
+		{"case sensitive", []string{"Action"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFirewallDimensions(tt.dimensions)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateFirewallDimensions(%v) error = %v, wantErr %v", tt.dimensions, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetEnvPositiveInt(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{"unset uses default", "", 20, false},
+		{"positive", "5", 5, false},
+		{"zero", "0", 0, true},
+		{"negative", "-1", 0, true},
+		{"not a number", "ten", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_ENV_LIMIT", tt.value)
+
+			got, err := getEnvPositiveInt("TEST_ENV_LIMIT", 20)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getEnvPositiveInt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("getEnvPositiveInt() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -39,9 +39,146 @@ type Metrics struct {
 	FirewallCountry   *prometheus.GaugeVec
 	FirewallIP        *prometheus.GaugeVec
 	FirewallUserAgent *prometheus.GaugeVec
+
+	OriginResponseStatus   *prometheus.GaugeVec
+	OriginCloudflareErrors *prometheus.GaugeVec
+	OriginStatusMismatch   *prometheus.GaugeVec
+
+	HTTPProtocolRequests *prometheus.GaugeVec
+	HTTPProtocolBytes    *prometheus.GaugeVec
+	HTTPProtocolRate     *prometheus.GaugeVec
+	TLSVersionRequests   *prometheus.GaugeVec
+	TLSVersionBytes      *prometheus.GaugeVec
+	TLSVersionRate       *prometheus.GaugeVec
+	IPVersionRequests    *prometheus.GaugeVec
+	IPVersionBytes       *prometheus.GaugeVec
+	IPVersionRate        *prometheus.GaugeVec
+
+	ColoRequests    *prometheus.GaugeVec
+	ColoBytes       *prometheus.GaugeVec
+	ColoErrorRate   *prometheus.GaugeVec
+	ColoEdgeLatency *prometheus.GaugeVec
+	ColoInfo        *prometheus.GaugeVec
+
+	ASNRequests       *prometheus.GaugeVec
+	ASNBytes          *prometheus.GaugeVec
+	ASNFirewallEvents *prometheus.GaugeVec
+
+	MethodRequests     *prometheus.GaugeVec
+	MethodBytes        *prometheus.GaugeVec
+	DeviceTypeRequests *prometheus.GaugeVec
+	DeviceTypeBytes    *prometheus.GaugeVec
+	BrowserRequests    *prometheus.GaugeVec
+	BrowserBytes       *prometheus.GaugeVec
+	OSRequests         *prometheus.GaugeVec
+	OSBytes            *prometheus.GaugeVec
+
+	BotScoreRequests    *prometheus.GaugeVec
+	VerifiedBotRequests *prometheus.GaugeVec
+	BotDecisionRequests *prometheus.GaugeVec
+
+	WorkerRequests         *prometheus.GaugeVec
+	WorkerErrors           *prometheus.GaugeVec
+	WorkerSubrequests      *prometheus.GaugeVec
+	WorkerWallTime         *prometheus.GaugeVec
+	WorkerCPUTimeQuantile  *prometheus.GaugeVec
+	WorkerWallTimeQuantile *prometheus.GaugeVec
+
+	R2Objects     *prometheus.GaugeVec
+	R2StoredBytes *prometheus.GaugeVec
+	R2Operations  *prometheus.GaugeVec
+
+	LBPoolHealthy      *prometheus.GaugeVec
+	LBOriginHealthy    *prometheus.GaugeVec
+	LBOriginRTT        *prometheus.GaugeVec
+	LBSteeringRequests *prometheus.GaugeVec
+	LBOriginRequests   *prometheus.GaugeVec
+
+	DNSQueries      *prometheus.GaugeVec
+	DNSQueryType    *prometheus.GaugeVec
+	DNSResponseCode *prometheus.GaugeVec
+	DNSQueryName    *prometheus.GaugeVec
+	DNSResponseTime *prometheus.GaugeVec
+
+	RateLimitTriggers *prometheus.GaugeVec
+	RateLimitRequests *prometheus.GaugeVec
+	RateLimitTopIPs   *prometheus.GaugeVec
+
+	FirewallRuleInfo *prometheus.GaugeVec
+
+	FirewallEventsBreakdown *prometheus.GaugeVec
+
+	CertificateExpiry *prometheus.GaugeVec
+	CertificateInfo   *prometheus.GaugeVec
+
+	ZoneSettingInfo    *prometheus.GaugeVec
+	ZoneSettingEnabled *prometheus.GaugeVec
+	ZoneSettingDrift   *prometheus.GaugeVec
+
+	DNSRecords              *prometheus.GaugeVec
+	DNSRecordInfo           *prometheus.GaugeVec
+	DNSRecordDecommissioned *prometheus.GaugeVec
+
+	TunnelStatus            *prometheus.GaugeVec
+	TunnelConnections       *prometheus.GaugeVec
+	TunnelActiveConnections *prometheus.GaugeVec
+	TunnelConnectorInfo     *prometheus.GaugeVec
+	TunnelActiveAt          *prometheus.GaugeVec
+	TunnelInactiveAt        *prometheus.GaugeVec
+
+	AccessLogins *prometheus.GaugeVec
+	AccessDenied *prometheus.GaugeVec
+
+	GatewayDNSQueries   *prometheus.GaugeVec
+	GatewayDNSCategory  *prometheus.GaugeVec
+	GatewayDNSDomain    *prometheus.GaugeVec
+	GatewayHTTPRequests *prometheus.GaugeVec
+
+	HealthCheckStatus              *prometheus.GaugeVec
+	HealthCheckFailureThreshold    *prometheus.GaugeVec
+	HealthCheckConsecutiveFailures *prometheus.GaugeVec
+	HealthCheckRTT                 *prometheus.GaugeVec
+
+	WaitingRoomStatus           *prometheus.GaugeVec
+	WaitingRoomActiveUsers      *prometheus.GaugeVec
+	WaitingRoomQueuedUsers      *prometheus.GaugeVec
+	WaitingRoomEstimatedWait    *prometheus.GaugeVec
+	WaitingRoomActiveUsersLimit *prometheus.GaugeVec
+	WaitingRoomNewUsersLimit    *prometheus.GaugeVec
+
+	PageShieldResources          *prometheus.GaugeVec
+	PageShieldNewResources       *prometheus.GaugeVec
+	PageShieldMaliciousResources *prometheus.GaugeVec
+	APIShieldEndpoints           *prometheus.GaugeVec
+	APIShieldDiscoveredEndpoints *prometheus.GaugeVec
+	APIShieldViolations          *prometheus.GaugeVec
+
+	LogpushJobEnabled   *prometheus.GaugeVec
+	LogpushLastComplete *prometheus.GaugeVec
+	LogpushLastError    *prometheus.GaugeVec
+	LogpushJobError     *prometheus.GaugeVec
+
+	SpectrumConnections    *prometheus.GaugeVec
+	SpectrumBytesIngress   *prometheus.GaugeVec
+	SpectrumBytesEgress    *prometheus.GaugeVec
+	SpectrumDurationAvg    *prometheus.GaugeVec
+	MagicTransitBitRate    *prometheus.GaugeVec
+	MagicTransitPacketRate *prometheus.GaugeVec
+
+	ImagesStored              *prometheus.GaugeVec
+	ImagesAllowed             *prometheus.GaugeVec
+	ImagesDelivered           *prometheus.GaugeVec
+	StreamStorageMinutes      *prometheus.GaugeVec
+	StreamStorageMinutesLimit *prometheus.GaugeVec
+	StreamVideos              *prometheus.GaugeVec
+	StreamMinutesViewed       *prometheus.GaugeVec
+	StreamVideoMinutesViewed  *prometheus.GaugeVec
+	StreamVideoViews          *prometheus.GaugeVec
 }
 
-func NewMetrics() *Metrics {
+// NewMetrics creates the metric definitions. firewallDimensions is the
+// label set of the multi-dimensional firewall events metric.
+func NewMetrics(firewallDimensions []string) *Metrics {
 	return &Metrics{
 		TotalRequests: prometheus.NewGaugeVec(
 			prometheus.GaugeOpts{
@@ -260,6 +397,776 @@ func NewMetrics() *Metrics {
 			},
 			[]string{"zone_id", "user_agent"},
 		),
+		OriginResponseStatus: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_origin_response_status",
+				Help: "Number of requests by origin HTTP status code",
+			},
+			[]string{"zone_id", "host", "status"},
+		),
+		OriginCloudflareErrors: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_origin_cloudflare_errors",
+				Help: "Number of Cloudflare 52x origin errors (520-527) by status code",
+			},
+			[]string{"zone_id", "host", "status"},
+		),
+		OriginStatusMismatch: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_origin_status_mismatch",
+				Help: "Number of requests where the edge status differs from the origin status",
+			},
+			[]string{"zone_id", "host"},
+		),
+		HTTPProtocolRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_requests_http_protocol",
+				Help: "Number of requests by HTTP protocol",
+			},
+			[]string{"zone_id", "protocol"},
+		),
+		HTTPProtocolBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_bandwidth_http_protocol_bytes",
+				Help: "Bandwidth by HTTP protocol in bytes",
+			},
+			[]string{"zone_id", "protocol"},
+		),
+		HTTPProtocolRate: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_http_protocol_rate_percent",
+				Help: "Percentage of requests by HTTP protocol",
+			},
+			[]string{"zone_id", "protocol"},
+		),
+		TLSVersionRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_requests_tls_version",
+				Help: "Number of requests by TLS version",
+			},
+			[]string{"zone_id", "tls_version"},
+		),
+		TLSVersionBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_bandwidth_tls_version_bytes",
+				Help: "Bandwidth by TLS version in bytes",
+			},
+			[]string{"zone_id", "tls_version"},
+		),
+		TLSVersionRate: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_tls_version_rate_percent",
+				Help: "Percentage of requests by TLS version",
+			},
+			[]string{"zone_id", "tls_version"},
+		),
+		IPVersionRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_requests_ip_version",
+				Help: "Number of requests by client IP version",
+			},
+			[]string{"zone_id", "ip_version"},
+		),
+		IPVersionBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_bandwidth_ip_version_bytes",
+				Help: "Bandwidth by client IP version in bytes",
+			},
+			[]string{"zone_id", "ip_version"},
+		),
+		IPVersionRate: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_ip_version_rate_percent",
+				Help: "Percentage of requests by client IP version",
+			},
+			[]string{"zone_id", "ip_version"},
+		),
+		ColoRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_colo_requests",
+				Help: "Number of requests by Cloudflare data center",
+			},
+			[]string{"zone_id", "colo"},
+		),
+		ColoBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_colo_bandwidth_bytes",
+				Help: "Bandwidth by Cloudflare data center in bytes",
+			},
+			[]string{"zone_id", "colo"},
+		),
+		ColoErrorRate: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_colo_error_rate_percent",
+				Help: "Percentage of 5xx responses by Cloudflare data center",
+			},
+			[]string{"zone_id", "colo"},
+		),
+		ColoEdgeLatency: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_colo_edge_ttfb_avg_ms",
+				Help: "Average edge time to first byte by Cloudflare data center in milliseconds",
+			},
+			[]string{"zone_id", "colo"},
+		),
+		ColoInfo: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_colo_info",
+				Help: "Location of a Cloudflare data center",
+			},
+			[]string{"colo", "city", "region", "continent"},
+		),
+		ASNRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_asn_requests",
+				Help: "Number of requests by client ASN",
+			},
+			[]string{"zone_id", "asn", "asn_description"},
+		),
+		ASNBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_asn_bandwidth_bytes",
+				Help: "Bandwidth by client ASN in bytes",
+			},
+			[]string{"zone_id", "asn", "asn_description"},
+		),
+		ASNFirewallEvents: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_asn_firewall_events",
+				Help: "Number of firewall events by client ASN",
+			},
+			[]string{"zone_id", "asn", "asn_description"},
+		),
+		MethodRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_requests_method",
+				Help: "Number of requests by HTTP method",
+			},
+			[]string{"zone_id", "method"},
+		),
+		MethodBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_bandwidth_method_bytes",
+				Help: "Bandwidth by HTTP method in bytes",
+			},
+			[]string{"zone_id", "method"},
+		),
+		DeviceTypeRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_requests_device_type",
+				Help: "Number of requests by client device type",
+			},
+			[]string{"zone_id", "device_type"},
+		),
+		DeviceTypeBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_bandwidth_device_type_bytes",
+				Help: "Bandwidth by client device type in bytes",
+			},
+			[]string{"zone_id", "device_type"},
+		),
+		BrowserRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_requests_browser",
+				Help: "Number of requests by browser family",
+			},
+			[]string{"zone_id", "browser"},
+		),
+		BrowserBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_bandwidth_browser_bytes",
+				Help: "Bandwidth by browser family in bytes",
+			},
+			[]string{"zone_id", "browser"},
+		),
+		OSRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_requests_os",
+				Help: "Number of requests by operating system family",
+			},
+			[]string{"zone_id", "os"},
+		),
+		OSBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_bandwidth_os_bytes",
+				Help: "Bandwidth by operating system family in bytes",
+			},
+			[]string{"zone_id", "os"},
+		),
+		BotScoreRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_bot_score_requests",
+				Help: "Number of requests by bot score bucket",
+			},
+			[]string{"zone_id", "host", "bucket"},
+		),
+		VerifiedBotRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_verified_bot_requests",
+				Help: "Number of requests by verified bot category",
+			},
+			[]string{"zone_id", "host", "category"},
+		),
+		BotDecisionRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_bot_management_decision_requests",
+				Help: "Number of requests by bot management decision",
+			},
+			[]string{"zone_id", "host", "decision"},
+		),
+		WorkerRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_worker_requests",
+				Help: "Number of Workers invocations by script and status",
+			},
+			[]string{"account_id", "script_name", "status"},
+		),
+		WorkerErrors: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_worker_errors",
+				Help: "Number of Workers invocation errors by script and status",
+			},
+			[]string{"account_id", "script_name", "status"},
+		),
+		WorkerSubrequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_worker_subrequests",
+				Help: "Number of Workers subrequests by script and status",
+			},
+			[]string{"account_id", "script_name", "status"},
+		),
+		WorkerWallTime: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_worker_wall_time_total_ms",
+				Help: "Total Workers wall time in milliseconds",
+			},
+			[]string{"account_id", "script_name", "status"},
+		),
+		WorkerCPUTimeQuantile: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_worker_cpu_time_ms",
+				Help: "Workers CPU time quantiles in milliseconds",
+			},
+			[]string{"account_id", "script_name", "status", "quantile"},
+		),
+		WorkerWallTimeQuantile: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_worker_wall_time_ms",
+				Help: "Workers wall time quantiles in milliseconds",
+			},
+			[]string{"account_id", "script_name", "status", "quantile"},
+		),
+		R2Objects: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_r2_objects",
+				Help: "Number of objects stored per R2 bucket",
+			},
+			[]string{"account_id", "bucket"},
+		),
+		R2StoredBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_r2_stored_bytes",
+				Help: "Bytes stored per R2 bucket including metadata",
+			},
+			[]string{"account_id", "bucket"},
+		),
+		R2Operations: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_r2_operations",
+				Help: "Number of R2 operations by class, action and status",
+			},
+			[]string{"account_id", "bucket", "class", "action", "status"},
+		),
+		LBPoolHealthy: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_lb_pool_healthy",
+				Help: "Whether a load balancer pool is healthy in a region (1 = healthy)",
+			},
+			[]string{"zone_id", "load_balancer", "pool", "region"},
+		),
+		LBOriginHealthy: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_lb_origin_healthy",
+				Help: "Whether a load balancer origin is healthy in a region (1 = healthy)",
+			},
+			[]string{"zone_id", "load_balancer", "pool", "origin", "region"},
+		),
+		LBOriginRTT: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_lb_origin_rtt_ms",
+				Help: "Load balancer origin health check round trip time in milliseconds",
+			},
+			[]string{"zone_id", "load_balancer", "pool", "origin", "region"},
+		),
+		LBSteeringRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_lb_steering_requests",
+				Help: "Number of load balanced requests by selected pool and steering policy",
+			},
+			[]string{"zone_id", "load_balancer", "pool", "steering_policy"},
+		),
+		LBOriginRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_lb_origin_requests",
+				Help: "Number of load balanced requests by selected origin",
+			},
+			[]string{"zone_id", "load_balancer", "pool", "origin"},
+		),
+		DNSQueries: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_dns_queries_total",
+				Help: "Total number of DNS queries",
+			},
+			[]string{"zone_id"},
+		),
+		DNSQueryType: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_dns_queries_type",
+				Help: "Number of DNS queries by query type",
+			},
+			[]string{"zone_id", "query_type"},
+		),
+		DNSResponseCode: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_dns_queries_response_code",
+				Help: "Number of DNS queries by response code",
+			},
+			[]string{"zone_id", "response_code"},
+		),
+		DNSQueryName: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_dns_queries_name",
+				Help: "Number of DNS queries by query name",
+			},
+			[]string{"zone_id", "query_name"},
+		),
+		DNSResponseTime: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_dns_response_time_ms",
+				Help: "DNS response time quantiles in milliseconds",
+			},
+			[]string{"zone_id", "quantile"},
+		),
+		RateLimitTriggers: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_ratelimit_rule_triggers",
+				Help: "Number of times a rate limiting rule triggered",
+			},
+			[]string{"zone_id", "rule_id", "rule_name"},
+		),
+		RateLimitRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_ratelimit_rule_requests",
+				Help: "Number of requests matched by a rate limiting rule by mode (mitigated or simulated)",
+			},
+			[]string{"zone_id", "rule_id", "rule_name", "mode"},
+		),
+		RateLimitTopIPs: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_ratelimit_rule_ip",
+				Help: "Number of rate limited requests by client IP (top 10 per rule)",
+			},
+			[]string{"zone_id", "rule_id", "rule_name", "ip"},
+		),
+		FirewallRuleInfo: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_firewall_rule_info",
+				Help: "Firewall rule description, ruleset and phase (always 1)",
+			},
+			[]string{"zone_id", "rule_id", "description", "ruleset", "phase"},
+		),
+		FirewallEventsBreakdown: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_firewall_events_breakdown",
+				Help: "Number of firewall events by the dimensions configured in FIREWALL_DIMENSIONS",
+			},
+			append([]string{"zone_id"}, firewallDimensions...),
+		),
+		CertificateExpiry: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_certificate_expiry_timestamp_seconds",
+				Help: "Certificate expiry time as a Unix timestamp",
+			},
+			[]string{"zone_id", "source", "certificate_id", "hosts"},
+		),
+		CertificateInfo: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_certificate_info",
+				Help: "Certificate status, issuer and validation method (always 1)",
+			},
+			[]string{"zone_id", "source", "certificate_id", "hosts", "status", "issuer", "validation_method"},
+		),
+		ZoneSettingInfo: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_setting_info",
+				Help: "Current value of a zone setting (always 1)",
+			},
+			[]string{"zone_id", "setting", "value"},
+		),
+		ZoneSettingEnabled: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_setting_enabled",
+				Help: "Whether an on/off zone setting is enabled (1 = on)",
+			},
+			[]string{"zone_id", "setting"},
+		),
+		ZoneSettingDrift: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_setting_drift",
+				Help: "Whether a zone setting differs from its expected baseline value (1 = drift)",
+			},
+			[]string{"zone_id", "setting", "expected"},
+		),
+		DNSRecords: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_dns_records",
+				Help: "Number of DNS records by type and proxied status",
+			},
+			[]string{"zone_id", "type", "proxied"},
+		),
+		DNSRecordInfo: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_dns_record_info",
+				Help: "DNS record details (always 1)",
+			},
+			[]string{"zone_id", "record_id", "name", "type", "content", "proxied", "ttl"},
+		),
+		DNSRecordDecommissioned: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_dns_record_decommissioned",
+				Help: "DNS record pointing at a decommissioned IP range (always 1)",
+			},
+			[]string{"zone_id", "name", "type", "content"},
+		),
+		TunnelStatus: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_tunnel_status",
+				Help: "Cloudflare Tunnel status (1 for the current status)",
+			},
+			[]string{"account_id", "tunnel_id", "tunnel_name", "status"},
+		),
+		TunnelConnections: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_tunnel_connections",
+				Help: "Number of active tunnel connections by Cloudflare data center",
+			},
+			[]string{"account_id", "tunnel_id", "tunnel_name", "colo"},
+		),
+		TunnelActiveConnections: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_tunnel_active_connections",
+				Help: "Number of active tunnel connections across all data centers",
+			},
+			[]string{"account_id", "tunnel_id", "tunnel_name"},
+		),
+		TunnelConnectorInfo: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_tunnel_connector_info",
+				Help: "Tunnel connector version and architecture (always 1)",
+			},
+			[]string{"account_id", "tunnel_id", "tunnel_name", "connector_id", "version", "arch"},
+		),
+		TunnelActiveAt: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_tunnel_active_at_timestamp_seconds",
+				Help: "Time the tunnel last became active as a Unix timestamp",
+			},
+			[]string{"account_id", "tunnel_id", "tunnel_name"},
+		),
+		TunnelInactiveAt: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_tunnel_inactive_at_timestamp_seconds",
+				Help: "Time the tunnel last lost all connections as a Unix timestamp",
+			},
+			[]string{"account_id", "tunnel_id", "tunnel_name"},
+		),
+		AccessLogins: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_access_logins",
+				Help: "Number of Access login attempts by application, identity provider, country and result",
+			},
+			[]string{"account_id", "app", "identity_provider", "country", "result"},
+		),
+		AccessDenied: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_access_denied_requests",
+				Help: "Number of requests denied by Access policies by application",
+			},
+			[]string{"account_id", "app"},
+		),
+		GatewayDNSQueries: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_gateway_dns_queries",
+				Help: "Number of Gateway DNS queries by decision and reason",
+			},
+			[]string{"account_id", "decision", "reason"},
+		),
+		GatewayDNSCategory: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_gateway_dns_queries_category",
+				Help: "Number of Gateway DNS queries by decision and content category",
+			},
+			[]string{"account_id", "decision", "category"},
+		),
+		GatewayDNSDomain: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_gateway_dns_queries_domain",
+				Help: "Number of Gateway DNS queries by domain and decision",
+			},
+			[]string{"account_id", "domain", "decision"},
+		),
+		GatewayHTTPRequests: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_gateway_http_requests",
+				Help: "Number of Gateway HTTP requests by action and policy",
+			},
+			[]string{"account_id", "action", "policy"},
+		),
+		HealthCheckStatus: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_healthcheck_status",
+				Help: "Health check status (1 for the current status)",
+			},
+			[]string{"zone_id", "health_check", "status"},
+		),
+		HealthCheckFailureThreshold: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_healthcheck_failure_threshold",
+				Help: "Consecutive failures required to mark a health check unhealthy",
+			},
+			[]string{"zone_id", "health_check"},
+		),
+		HealthCheckConsecutiveFailures: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_healthcheck_consecutive_failures",
+				Help: "Current number of consecutive failed health checks by region",
+			},
+			[]string{"zone_id", "health_check", "region"},
+		),
+		HealthCheckRTT: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_healthcheck_rtt_ms",
+				Help: "Average health check round trip time by region in milliseconds",
+			},
+			[]string{"zone_id", "health_check", "region"},
+		),
+		WaitingRoomStatus: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_waiting_room_status",
+				Help: "Waiting room status (1 for the current status)",
+			},
+			[]string{"zone_id", "waiting_room", "status"},
+		),
+		WaitingRoomActiveUsers: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_waiting_room_active_users",
+				Help: "Estimated number of users currently active on the origin",
+			},
+			[]string{"zone_id", "waiting_room"},
+		),
+		WaitingRoomQueuedUsers: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_waiting_room_queued_users",
+				Help: "Estimated number of users currently queued",
+			},
+			[]string{"zone_id", "waiting_room"},
+		),
+		WaitingRoomEstimatedWait: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_waiting_room_estimated_wait_minutes",
+				Help: "Maximum estimated wait time in minutes",
+			},
+			[]string{"zone_id", "waiting_room"},
+		),
+		WaitingRoomActiveUsersLimit: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_waiting_room_active_users_limit",
+				Help: "Configured total active users threshold",
+			},
+			[]string{"zone_id", "waiting_room"},
+		),
+		WaitingRoomNewUsersLimit: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_waiting_room_new_users_per_minute_limit",
+				Help: "Configured new users per minute threshold",
+			},
+			[]string{"zone_id", "waiting_room"},
+		),
+		PageShieldResources: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_page_shield_resources",
+				Help: "Number of scripts or connections detected by Page Shield",
+			},
+			[]string{"zone_id", "resource"},
+		),
+		PageShieldNewResources: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_page_shield_new_resources",
+				Help: "Number of scripts or connections first seen in the last 24 hours",
+			},
+			[]string{"zone_id", "resource"},
+		),
+		PageShieldMaliciousResources: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_page_shield_malicious_resources",
+				Help: "Number of scripts or connections flagged as malicious",
+			},
+			[]string{"zone_id", "resource"},
+		),
+		APIShieldEndpoints: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_api_shield_endpoints",
+				Help: "Number of endpoints managed by API Shield",
+			},
+			[]string{"zone_id"},
+		),
+		APIShieldDiscoveredEndpoints: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_api_shield_discovered_endpoints",
+				Help: "Number of endpoints found by API Discovery by review state",
+			},
+			[]string{"zone_id", "state"},
+		),
+		APIShieldViolations: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_api_shield_schema_violations",
+				Help: "Number of schema validation violations by endpoint and action (top 100)",
+			},
+			[]string{"zone_id", "method", "endpoint", "action"},
+		),
+		LogpushJobEnabled: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_logpush_job_enabled",
+				Help: "Whether a Logpush job is enabled (1 = enabled)",
+			},
+			[]string{"scope", "scope_id", "job_id", "job_name", "dataset"},
+		),
+		LogpushLastComplete: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_logpush_job_last_complete_timestamp_seconds",
+				Help: "Time of the last successful Logpush push as a Unix timestamp",
+			},
+			[]string{"scope", "scope_id", "job_id", "job_name", "dataset"},
+		),
+		LogpushLastError: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_logpush_job_last_error_timestamp_seconds",
+				Help: "Time of the last failed Logpush push as a Unix timestamp",
+			},
+			[]string{"scope", "scope_id", "job_id", "job_name", "dataset"},
+		),
+		LogpushJobError: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_logpush_job_error_info",
+				Help: "Last error message reported for a Logpush job (always 1)",
+			},
+			[]string{"scope", "scope_id", "job_id", "job_name", "dataset", "error_message"},
+		),
+		SpectrumConnections: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_spectrum_connections",
+				Help: "Number of Spectrum connections by application",
+			},
+			[]string{"zone_id", "app_id", "app_name", "protocol"},
+		),
+		SpectrumBytesIngress: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_spectrum_bytes_ingress",
+				Help: "Spectrum ingress bytes by application",
+			},
+			[]string{"zone_id", "app_id", "app_name", "protocol"},
+		),
+		SpectrumBytesEgress: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_spectrum_bytes_egress",
+				Help: "Spectrum egress bytes by application",
+			},
+			[]string{"zone_id", "app_id", "app_name", "protocol"},
+		),
+		SpectrumDurationAvg: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_zone_spectrum_connection_duration_avg_ms",
+				Help: "Average Spectrum connection duration in milliseconds",
+			},
+			[]string{"zone_id", "app_id", "app_name", "protocol"},
+		),
+		MagicTransitBitRate: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_magic_transit_bits_per_second",
+				Help: "Magic Transit traffic rate in bits per second by action",
+			},
+			[]string{"account_id", "action"},
+		),
+		MagicTransitPacketRate: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_magic_transit_packets_per_second",
+				Help: "Magic Transit traffic rate in packets per second by action",
+			},
+			[]string{"account_id", "action"},
+		),
+		ImagesStored: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_images_stored",
+				Help: "Number of images stored in Cloudflare Images",
+			},
+			[]string{"account_id"},
+		),
+		ImagesAllowed: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_images_allowed",
+				Help: "Number of images allowed by the Cloudflare Images plan",
+			},
+			[]string{"account_id"},
+		),
+		ImagesDelivered: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_images_delivered",
+				Help: "Number of images delivered by Cloudflare Images",
+			},
+			[]string{"account_id"},
+		),
+		StreamStorageMinutes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_stream_storage_minutes",
+				Help: "Minutes of video stored in Cloudflare Stream",
+			},
+			[]string{"account_id"},
+		),
+		StreamStorageMinutesLimit: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_stream_storage_minutes_limit",
+				Help: "Minutes of video storage allowed by the Cloudflare Stream plan",
+			},
+			[]string{"account_id"},
+		),
+		StreamVideos: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_stream_videos",
+				Help: "Number of videos stored in Cloudflare Stream",
+			},
+			[]string{"account_id"},
+		),
+		StreamMinutesViewed: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_stream_minutes_viewed",
+				Help: "Minutes of Cloudflare Stream video viewed",
+			},
+			[]string{"account_id"},
+		),
+		StreamVideoMinutesViewed: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_stream_video_minutes_viewed",
+				Help: "Minutes viewed per Cloudflare Stream video",
+			},
+			[]string{"account_id", "video_id", "video_name"},
+		),
+		StreamVideoViews: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "cloudflare_stream_video_views",
+				Help: "Number of views per Cloudflare Stream video",
+			},
+			[]string{"account_id", "video_id", "video_name"},
+		),
 	}
 }
 
@@ -296,5 +1203,115 @@ func (m *Metrics) Register() {
 		m.FirewallCountry,
 		m.FirewallIP,
 		m.FirewallUserAgent,
+		m.OriginResponseStatus,
+		m.OriginCloudflareErrors,
+		m.OriginStatusMismatch,
+		m.HTTPProtocolRequests,
+		m.HTTPProtocolBytes,
+		m.HTTPProtocolRate,
+		m.TLSVersionRequests,
+		m.TLSVersionBytes,
+		m.TLSVersionRate,
+		m.IPVersionRequests,
+		m.IPVersionBytes,
+		m.IPVersionRate,
+		m.ColoRequests,
+		m.ColoBytes,
+		m.ColoErrorRate,
+		m.ColoEdgeLatency,
+		m.ColoInfo,
+		m.ASNRequests,
+		m.ASNBytes,
+		m.ASNFirewallEvents,
+		m.MethodRequests,
+		m.MethodBytes,
+		m.DeviceTypeRequests,
+		m.DeviceTypeBytes,
+		m.BrowserRequests,
+		m.BrowserBytes,
+		m.OSRequests,
+		m.OSBytes,
+		m.BotScoreRequests,
+		m.VerifiedBotRequests,
+		m.BotDecisionRequests,
+		m.WorkerRequests,
+		m.WorkerErrors,
+		m.WorkerSubrequests,
+		m.WorkerWallTime,
+		m.WorkerCPUTimeQuantile,
+		m.WorkerWallTimeQuantile,
+		m.R2Objects,
+		m.R2StoredBytes,
+		m.R2Operations,
+		m.LBPoolHealthy,
+		m.LBOriginHealthy,
+		m.LBOriginRTT,
+		m.LBSteeringRequests,
+		m.LBOriginRequests,
+		m.DNSQueries,
+		m.DNSQueryType,
+		m.DNSResponseCode,
+		m.DNSQueryName,
+		m.DNSResponseTime,
+		m.RateLimitTriggers,
+		m.RateLimitRequests,
+		m.RateLimitTopIPs,
+		m.FirewallRuleInfo,
+		m.FirewallEventsBreakdown,
+		m.CertificateExpiry,
+		m.CertificateInfo,
+		m.ZoneSettingInfo,
+		m.ZoneSettingEnabled,
+		m.ZoneSettingDrift,
+		m.DNSRecords,
+		m.DNSRecordInfo,
+		m.DNSRecordDecommissioned,
+		m.TunnelStatus,
+		m.TunnelConnections,
+		m.TunnelActiveConnections,
+		m.TunnelConnectorInfo,
+		m.TunnelActiveAt,
+		m.TunnelInactiveAt,
+		m.AccessLogins,
+		m.AccessDenied,
+		m.GatewayDNSQueries,
+		m.GatewayDNSCategory,
+		m.GatewayDNSDomain,
+		m.GatewayHTTPRequests,
+		m.HealthCheckStatus,
+		m.HealthCheckFailureThreshold,
+		m.HealthCheckConsecutiveFailures,
+		m.HealthCheckRTT,
+		m.WaitingRoomStatus,
+		m.WaitingRoomActiveUsers,
+		m.WaitingRoomQueuedUsers,
+		m.WaitingRoomEstimatedWait,
+		m.WaitingRoomActiveUsersLimit,
+		m.WaitingRoomNewUsersLimit,
+		m.PageShieldResources,
+		m.PageShieldNewResources,
+		m.PageShieldMaliciousResources,
+		m.APIShieldEndpoints,
+		m.APIShieldDiscoveredEndpoints,
+		m.APIShieldViolations,
+		m.LogpushJobEnabled,
+		m.LogpushLastComplete,
+		m.LogpushLastError,
+		m.LogpushJobError,
+		m.SpectrumConnections,
+		m.SpectrumBytesIngress,
+		m.SpectrumBytesEgress,
+		m.SpectrumDurationAvg,
+		m.MagicTransitBitRate,
+		m.MagicTransitPacketRate,
+		m.ImagesStored,
+		m.ImagesAllowed,
+		m.ImagesDelivered,
+		m.StreamStorageMinutes,
+		m.StreamStorageMinutesLimit,
+		m.StreamVideos,
+		m.StreamMinutesViewed,
+		m.StreamVideoMinutesViewed,
+		m.StreamVideoViews,
 	)
 }
\ No newline at end of file
@@ -13,9 +13,20 @@ const (
 	graphQLURL = "https://api.cloudflare.com/client/v4/graphql"
 )
 
+// GraphQLError is returned when the API responds successfully but reports
+// an error for the query itself, e.g. a dataset the plan has no access to.
+type GraphQLError struct {
+	Message string
+}
+
+func (e *GraphQLError) Error() string {
+	return fmt.Sprintf("GraphQL error: %s", e.Message)
+}
+
 type Client struct {
 	apiToken   string
 	httpClient *http.Client
+	restURL    string
 }
 
 func NewClient(apiToken string) *Client {
@@ -24,6 +35,7 @@ func NewClient(apiToken string) *Client {
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		restURL: restBaseURL,
 	}
 }
 
@@ -64,8 +76,40 @@ func (c *Client) ExecuteQuery(query string) (map[string]interface{}, error) {
 
 	if errors, ok := result["errors"].([]interface{}); ok && len(errors) > 0 {
 		errMap := errors[0].(map[string]interface{})
-		return nil, fmt.Errorf("GraphQL error: %v", errMap["message"])
+		return nil, &GraphQLError{Message: fmt.Sprintf("%v", errMap["message"])}
 	}
 
 	return result, nil
-}
\ No newline at end of file
+}
+
+// ExecuteAccountQuery runs the given dataset selection scoped to a single
+// account and returns that account's node from the response.
+func (c *Client) ExecuteAccountQuery(accountID, selection string) (map[string]interface{}, error) {
+	query := fmt.Sprintf(`{
+		viewer {
+			accounts(filter: {accountTag: "%s"}) {
+				%s
+			}
+		}
+	}`, accountID, selection)
+
+	result, err := c.ExecuteQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected response: missing data")
+	}
+	viewer, ok := data["viewer"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected response: missing viewer")
+	}
+	accounts, ok := viewer["accounts"].([]interface{})
+	if !ok || len(accounts) == 0 {
+		return nil, fmt.Errorf("no accounts found")
+	}
+
+	return accounts[0].(map[string]interface{}), nil
+}
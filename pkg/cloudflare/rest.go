@@ -0,0 +1,119 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	restBaseURL = "https://api.cloudflare.com/client/v4"
+
+	// restPageSize is accepted by every paginated endpoint the exporter uses
+	restPageSize = 50
+)
+
+// APIError is returned when a REST call fails, keeping the HTTP status so
+// callers can tell missing permissions apart from transient failures.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// Get performs a GET request against the REST API and returns the
+// "result" field of the response envelope.
+func (c *Client) Get(path string) (interface{}, error) {
+	envelope, err := c.getEnvelope(path)
+	if err != nil {
+		return nil, err
+	}
+	return envelope["result"], nil
+}
+
+// GetAll follows page-based pagination and returns the combined results
+// of a list endpoint.
+func (c *Client) GetAll(path string) ([]interface{}, error) {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+
+	var all []interface{}
+	for page := 1; ; page++ {
+		envelope, err := c.getEnvelope(fmt.Sprintf("%s%spage=%d&per_page=%d", path, sep, page, restPageSize))
+		if err != nil {
+			return nil, err
+		}
+
+		results, ok := envelope["result"].([]interface{})
+		if !ok || len(results) == 0 {
+			break
+		}
+		all = append(all, results...)
+
+		info, ok := envelope["result_info"].(map[string]interface{})
+		if !ok {
+			break
+		}
+		totalPages, _ := info["total_pages"].(float64)
+		if float64(page) >= totalPages {
+			break
+		}
+	}
+
+	return all, nil
+}
+
+func (c *Client) getEnvelope(path string) (map[string]interface{}, error) {
+	req, err := http.NewRequest("GET", c.restURL+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.apiToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var envelope map[string]interface{}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, &APIError{StatusCode: resp.StatusCode, Message: string(body)}
+		}
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{StatusCode: resp.StatusCode, Message: firstErrorMessage(envelope, string(body))}
+	}
+
+	if success, ok := envelope["success"].(bool); ok && !success {
+		return nil, &APIError{StatusCode: resp.StatusCode, Message: firstErrorMessage(envelope, string(body))}
+	}
+
+	return envelope, nil
+}
+
+func firstErrorMessage(envelope map[string]interface{}, fallback string) string {
+	if errors, ok := envelope["errors"].([]interface{}); ok && len(errors) > 0 {
+		if errMap, ok := errors[0].(map[string]interface{}); ok {
+			return fmt.Sprintf("%v", errMap["message"])
+		}
+	}
+	return fallback
+}
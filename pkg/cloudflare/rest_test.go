@@ -0,0 +1,184 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := NewClient("test-token")
+	c.restURL = server.URL
+	return c
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, v interface{}) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		t.Fatalf("encode response: %v", err)
+	}
+}
+
+func TestGetAllFollowsTotalPages(t *testing.T) {
+	tests := []struct {
+		name       string
+		pages      [][]string
+		totalPages int
+		wantIDs    []string
+		wantCalls  int
+	}{
+		{
+			name:       "single page",
+			pages:      [][]string{{"a", "b"}},
+			totalPages: 1,
+			wantIDs:    []string{"a", "b"},
+			wantCalls:  1,
+		},
+		{
+			name:       "three pages",
+			pages:      [][]string{{"a", "b"}, {"c", "d"}, {"e"}},
+			totalPages: 3,
+			wantIDs:    []string{"a", "b", "c", "d", "e"},
+			wantCalls:  3,
+		},
+		{
+			name:       "stops on empty page",
+			pages:      [][]string{{"a"}, {}},
+			totalPages: 5,
+			wantIDs:    []string{"a"},
+			wantCalls:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+					t.Errorf("Authorization = %q, want bearer token", got)
+				}
+				if got := r.URL.Query().Get("per_page"); got != strconv.Itoa(restPageSize) {
+					t.Errorf("per_page = %q, want %d", got, restPageSize)
+				}
+				if got := r.URL.Query().Get("is_deleted"); got != "false" {
+					t.Errorf("existing query parameter lost: is_deleted = %q", got)
+				}
+
+				page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+				var results []map[string]string
+				if page >= 1 && page <= len(tt.pages) {
+					for _, id := range tt.pages[page-1] {
+						results = append(results, map[string]string{"id": id})
+					}
+				}
+				writeJSON(t, w, http.StatusOK, map[string]interface{}{
+					"success":     true,
+					"result":      results,
+					"result_info": map[string]int{"page": page, "total_pages": tt.totalPages},
+				})
+			})
+
+			got, err := c.GetAll("/things?is_deleted=false")
+			if err != nil {
+				t.Fatalf("GetAll: %v", err)
+			}
+
+			var ids []string
+			for _, item := range got {
+				ids = append(ids, item.(map[string]interface{})["id"].(string))
+			}
+			if len(ids) != len(tt.wantIDs) {
+				t.Fatalf("ids = %v, want %v", ids, tt.wantIDs)
+			}
+			for i := range ids {
+				if ids[i] != tt.wantIDs[i] {
+					t.Fatalf("ids = %v, want %v", ids, tt.wantIDs)
+				}
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestGetEnvelopeErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        interface{}
+		wantStatus  int
+		wantMessage string
+	}{
+		{
+			name:   "success false",
+			status: http.StatusOK,
+			body: map[string]interface{}{
+				"success": false,
+				"errors":  []map[string]interface{}{{"code": 1000, "message": "invalid request"}},
+			},
+			wantStatus:  http.StatusOK,
+			wantMessage: "invalid request",
+		},
+		{
+			name:   "forbidden",
+			status: http.StatusForbidden,
+			body: map[string]interface{}{
+				"success": false,
+				"errors":  []map[string]interface{}{{"code": 10000, "message": "Authentication error"}},
+			},
+			wantStatus:  http.StatusForbidden,
+			wantMessage: "Authentication error",
+		},
+		{
+			name:        "non-JSON error body",
+			status:      http.StatusBadGateway,
+			body:        "bad gateway",
+			wantStatus:  http.StatusBadGateway,
+			wantMessage: "bad gateway",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if s, ok := tt.body.(string); ok {
+					w.WriteHeader(tt.status)
+					w.Write([]byte(s))
+					return
+				}
+				writeJSON(t, w, tt.status, tt.body)
+			})
+
+			for _, call := range []struct {
+				name string
+				fn   func() error
+			}{
+				{"Get", func() error { _, err := c.Get("/thing"); return err }},
+				{"GetAll", func() error { _, err := c.GetAll("/things"); return err }},
+			} {
+				err := call.fn()
+				var apiErr *APIError
+				if !errors.As(err, &apiErr) {
+					t.Fatalf("%s error = %v, want *APIError", call.name, err)
+				}
+				if apiErr.StatusCode != tt.wantStatus {
+					t.Errorf("%s StatusCode = %d, want %d", call.name, apiErr.StatusCode, tt.wantStatus)
+				}
+				if apiErr.Message != tt.wantMessage {
+					t.Errorf("%s Message = %q, want %q", call.name, apiErr.Message, tt.wantMessage)
+				}
+			}
+		})
+	}
+}